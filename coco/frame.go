@@ -0,0 +1,59 @@
+package coco
+
+import (
+	"math"
+
+	"github.com/ilbagatto/vsop87-go/mathutils"
+)
+
+// Frame bias between the ICRS and the mean equator and equinox of J2000
+// (IERS Conventions 2003), radians.
+const (
+	masToRad = math.Pi / (180 * 3600 * 1000)
+
+	biasDAlpha = -14.6 * masToRad   // ICRS right ascension offset of the J2000 equinox
+	biasXi     = -16.6170 * masToRad // ξ₀, celestial pole offset
+	biasEta    = -6.8192 * masToRad  // η₀, celestial pole offset
+)
+
+// J2000ToICRS converts equatorial coordinates referred to the mean equator
+// and equinox of J2000 to the ICRS by applying the frame bias.
+//
+//	ra, dec : right ascension and declination, radians
+func J2000ToICRS(ra, dec float64) (ra1, dec1 float64) {
+	p := equToRect(ra, dec)
+	// transpose of the bias matrix, to first order
+	return rectToEqu(mathutils.Point3D{
+		X: p.X - biasDAlpha*p.Y + biasXi*p.Z,
+		Y: biasDAlpha*p.X + p.Y + biasEta*p.Z,
+		Z: -biasXi*p.X - biasEta*p.Y + p.Z,
+	})
+}
+
+// ICRSToJ2000 converts equatorial coordinates referred to the ICRS to the
+// mean equator and equinox of J2000. It is the inverse of J2000ToICRS.
+func ICRSToJ2000(ra, dec float64) (ra0, dec0 float64) {
+	p := equToRect(ra, dec)
+	// bias matrix, to first order
+	return rectToEqu(mathutils.Point3D{
+		X: p.X + biasDAlpha*p.Y - biasXi*p.Z,
+		Y: -biasDAlpha*p.X + p.Y - biasEta*p.Z,
+		Z: biasXi*p.X + biasEta*p.Y + p.Z,
+	})
+}
+
+// equToRect returns the unit vector pointing to (ra, dec).
+func equToRect(ra, dec float64) mathutils.Point3D {
+	cosDec := math.Cos(dec)
+	return mathutils.Point3D{
+		X: cosDec * math.Cos(ra),
+		Y: cosDec * math.Sin(ra),
+		Z: math.Sin(dec),
+	}
+}
+
+// rectToEqu returns right ascension and declination of vector p.
+func rectToEqu(p mathutils.Point3D) (ra, dec float64) {
+	sph := p.ToSpherical()
+	return sph.Phi, sph.Theta
+}
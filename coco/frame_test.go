@@ -0,0 +1,37 @@
+package coco_test
+
+import (
+	"testing"
+
+	"github.com/ilbagatto/vsop87-go/coco"
+	"github.com/ilbagatto/vsop87-go/mathutils"
+)
+
+func TestJ2000ToICRS(t *testing.T) {
+	const threshold = 1e-4 // mas
+
+	// at the J2000 equinox the offsets are dα₀ and −ξ₀
+	ra, dec := coco.J2000ToICRS(0, 0)
+	gotRA := mathutils.Degrees(mathutils.AngNormPi(ra)) * 3600 * 1000
+	gotDec := mathutils.Degrees(dec) * 3600 * 1000
+
+	if !mathutils.AlmostEqual(gotRA, -14.6, threshold) {
+		t.Errorf("RA offset should be %.4f mas. Got: %.4f", -14.6, gotRA)
+	}
+	if !mathutils.AlmostEqual(gotDec, 16.617, threshold) {
+		t.Errorf("Dec offset should be %.4f mas. Got: %.4f", 16.617, gotDec)
+	}
+}
+
+func TestICRSToJ2000(t *testing.T) {
+	const threshold = 1e-12
+	ra, dec := mathutils.Radians(232.9), mathutils.Radians(-4.5)
+
+	gotRA, gotDec := coco.ICRSToJ2000(coco.J2000ToICRS(ra, dec))
+	if !mathutils.AlmostEqual(gotRA, ra, threshold) {
+		t.Errorf("RA should be %.12f. Got: %.12f", ra, gotRA)
+	}
+	if !mathutils.AlmostEqual(gotDec, dec, threshold) {
+		t.Errorf("Dec should be %.12f. Got: %.12f", dec, gotDec)
+	}
+}
@@ -47,3 +47,48 @@ func Astrometric2000ToMean(lam0, beta0, jd float64) (lam, beta float64) {
 	beta = math.Asin(C)
 	return
 }
+
+// PrecessEcliptic converts ecliptic coordinates from the mean ecliptic and
+// equinox of jd0 to those of jd (Meeus, formulae 21.5 and 21.7).
+//
+// lam0, beta0 : longitude and latitude at epoch jd0 (radians)
+// Returns lam, beta in radians, referred to epoch jd.
+func PrecessEcliptic(lam0, beta0, jd0, jd float64) (lam, beta float64) {
+	// T from J2000 to the starting epoch, t from the starting epoch to the final one
+	T := (jd0 - timeutils.J2000) / timeutils.DaysPerCent
+	t := (jd - jd0) / timeutils.DaysPerCent
+
+	// η, Π, p in arc-seconds
+	etaSec := mathutils.Polynome(t, 0,
+		mathutils.Polynome(T, 47.0029, -0.06603, 0.000598),
+		mathutils.Polynome(T, -0.03302, 0.000598),
+		0.000060)
+	piSec := mathutils.Polynome(T, 174.876384*3600, 3289.4789, 0.60622) -
+		mathutils.Polynome(T, 869.8089, 0.50491)*t + 0.03536*t*t
+	pSec := mathutils.Polynome(t, 0,
+		mathutils.Polynome(T, 5029.0966, 2.22226, -0.000042),
+		mathutils.Polynome(T, 1.11113, -0.000042),
+		-0.000006)
+
+	eta := mathutils.Radians(etaSec / 3600)
+	pi := mathutils.Radians(piSec / 3600)
+	p := mathutils.Radians(pSec / 3600)
+
+	cosEta, sinEta := math.Cos(eta), math.Sin(eta)
+	cosBeta, sinBeta := math.Cos(beta0), math.Sin(beta0)
+	sinD, cosD := math.Sincos(pi - lam0)
+
+	A := cosEta*cosBeta*sinD - sinEta*sinBeta
+	B := cosBeta * cosD
+	C := cosEta*sinBeta + sinEta*cosBeta*sinD
+
+	lam = mathutils.ReduceRad(p + pi - math.Atan2(A, B))
+	beta = math.Asin(C)
+	return
+}
+
+// MeanToAstrometric2000 converts ecliptic coordinates from the mean equinox
+// of date jd to the J2000 frame. It is the inverse of Astrometric2000ToMean.
+func MeanToAstrometric2000(lam, beta, jd float64) (lam0, beta0 float64) {
+	return PrecessEcliptic(lam, beta, jd, timeutils.J2000)
+}
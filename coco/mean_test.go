@@ -32,3 +32,36 @@ func TestAstrometric2000ToMean(t *testing.T) {
 		t.Errorf("Beta should be %.5f. Got: %.5f", exp_bet, got_bet)
 	}
 }
+
+func TestPrecessEcliptic(t *testing.T) {
+	const threshold = 1e-3
+
+	// J.Meeus, p. 137: the same example, from J2000 to -214 June 30.0
+	gotLam, gotBet := coco.PrecessEcliptic(
+		mathutils.Radians(149.48194),
+		mathutils.Radians(1.76549),
+		2451545.0,
+		1643074.5)
+	if got := mathutils.Degrees(gotLam); !mathutils.AlmostEqual(got, 118.704, threshold) {
+		t.Errorf("Lambda should be %.5f. Got: %.5f", 118.704, got)
+	}
+	if got := mathutils.Degrees(gotBet); !mathutils.AlmostEqual(got, 1.615, threshold) {
+		t.Errorf("Beta should be %.5f. Got: %.5f", 1.615, got)
+	}
+}
+
+func TestMeanToAstrometric2000(t *testing.T) {
+	const threshold = 1e-9
+	const jd = 2460000.5
+
+	lam, bet := mathutils.Radians(231.5), mathutils.Radians(14.2)
+	lam0, bet0 := coco.MeanToAstrometric2000(lam, bet, jd)
+	gotLam, gotBet := coco.Astrometric2000ToMean(lam0, bet0, jd)
+
+	if !mathutils.AlmostEqual(gotLam, lam, threshold) {
+		t.Errorf("Lambda should be %.9f. Got: %.9f", lam, gotLam)
+	}
+	if !mathutils.AlmostEqual(gotBet, bet, threshold) {
+		t.Errorf("Beta should be %.9f. Got: %.9f", bet, gotBet)
+	}
+}
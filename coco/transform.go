@@ -63,3 +63,24 @@ func Equ2Hor(ra, dec, h, phi float64) (azm, alt float64) {
 	alt = math.Asin(sinPhi*math.Sin(dec) + cosPhi*math.Cos(dec)*cosH)
 	return
 }
+
+// Hor2Equ converts horizontal (azimuth, altitude) to equatorial
+// (hour angle, declination). It is the inverse of Equ2Hor.
+//
+//	azm : azimuth, radians (westwards from South)
+//	alt : altitude, radians
+//	phi : observer’s latitude, radians (positive north)
+//
+// Returns local hour angle (westwards from South) and declination, radians.
+func Hor2Equ(azm, alt, phi float64) (h, dec float64) {
+	cosA := math.Cos(azm)
+	cosPhi := math.Cos(phi)
+	sinPhi := math.Sin(phi)
+
+	h = mathutils.ReduceRad(math.Atan2(
+		math.Sin(azm),
+		cosA*sinPhi+math.Tan(alt)*cosPhi,
+	))
+	dec = math.Asin(sinPhi*math.Sin(alt) - cosPhi*math.Cos(alt)*cosA)
+	return
+}
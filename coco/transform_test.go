@@ -81,3 +81,28 @@ func TestEqu2Hor(t *testing.T) {
 		t.Errorf("Altitude should be %.5f. Got: %.5f", exp_alt, got_alt)
 	}
 }
+
+func TestHor2Equ(t *testing.T) {
+	const threshold = 1e-3
+	azm := 68.0337
+	alt := 15.1249
+	phi := 38.92138888888889
+
+	exp_h := 64.352133
+	exp_de := -6.719891666666666
+	got_h, got_de := coco.Hor2Equ(
+		mathutils.Radians(azm),
+		mathutils.Radians(alt),
+		mathutils.Radians(phi))
+
+	got_h = mathutils.Degrees(got_h)
+	got_de = mathutils.Degrees(got_de)
+
+	if !mathutils.AlmostEqual(got_h, exp_h, threshold) {
+		t.Errorf("Hour angle should be %.5f. Got: %.5f", exp_h, got_h)
+	}
+
+	if !mathutils.AlmostEqual(got_de, exp_de, threshold) {
+		t.Errorf("Declination should be %.5f. Got: %.5f", exp_de, got_de)
+	}
+}
@@ -0,0 +1,27 @@
+package earth
+
+import "math"
+
+// Ellipsoid is a reference ellipsoid approximating the figure of the Earth.
+type Ellipsoid struct {
+	A float64 // equatorial radius, km
+	F float64 // flattening
+}
+
+// WGS84 is the World Geodetic System 1984 ellipsoid.
+var WGS84 = Ellipsoid{A: 6378.137, F: 1 / 298.257223563}
+
+// IAU1976 is the ellipsoid adopted by the IAU in 1976 and used by Meeus.
+var IAU1976 = Ellipsoid{A: 6378.14, F: 1 / 298.257}
+
+// ParallaxFactors returns ρ·sin φ′ and ρ·cos φ′ (in units of the equatorial
+// radius) for an observer at geodetic latitude phi (radians) and height h
+// (meters) above the ellipsoid. See Meeus, ch. 11.
+func (e Ellipsoid) ParallaxFactors(phi, h float64) (rhoSinPhi, rhoCosPhi float64) {
+	ba := 1 - e.F // b/a
+	u := math.Atan(ba * math.Tan(phi))
+	ha := h / (e.A * 1000)
+	rhoSinPhi = ba*math.Sin(u) + ha*math.Sin(phi)
+	rhoCosPhi = math.Cos(u) + ha*math.Cos(phi)
+	return
+}
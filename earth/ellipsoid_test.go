@@ -0,0 +1,22 @@
+package earth_test
+
+import (
+	"testing"
+
+	"github.com/ilbagatto/vsop87-go/earth"
+	"github.com/ilbagatto/vsop87-go/mathutils"
+)
+
+func TestParallaxFactors(t *testing.T) {
+	// Meeus, p.82: Palomar Observatory
+	phi := mathutils.Radians(33 + 21.0/60 + 22.0/3600)
+	gotSin, gotCos := earth.IAU1976.ParallaxFactors(phi, 1706)
+	expSin := 0.546861
+	expCos := 0.836339
+	if !mathutils.AlmostEqual(gotSin, expSin, threshold) {
+		t.Errorf("ρ·sinφ′ should be %.6f. Got: %.6f", expSin, gotSin)
+	}
+	if !mathutils.AlmostEqual(gotCos, expCos, threshold) {
+		t.Errorf("ρ·cosφ′ should be %.6f. Got: %.6f", expCos, gotCos)
+	}
+}
@@ -26,6 +26,9 @@ var coeffs = []float64{
 	2.45,
 }
 
+// ObliquityJ2000 is the mean obliquity of the ecliptic at J2000 (radians).
+var ObliquityJ2000 = Obliquity(timeutils.J2000, 0)
+
 // Obliquity returns the mean obliquity ε₀ (in radians) of the ecliptic of date.
 // Implements the polynomial from Meeus §22.2–§22.3:
 //
@@ -0,0 +1,45 @@
+package earth
+
+import (
+	"math"
+
+	"github.com/ilbagatto/vsop87-go/mathutils"
+)
+
+// Atmosphere describes the conditions at the observer which affect
+// atmospheric refraction.
+type Atmosphere struct {
+	Pressure    float64 // millibars (hPa)
+	Temperature float64 // degrees Celsius
+}
+
+// StandardAtmosphere is the atmosphere assumed by the refraction formulae
+// of Meeus, ch. 16: 1010 mbar and 10°C.
+var StandardAtmosphere = Atmosphere{Pressure: 1010, Temperature: 10}
+
+// minRefractionAlt is the lowest altitude (degrees) at which the refraction
+// formulae are evaluated; below it the value at this altitude is used.
+const minRefractionAlt = -1.0
+
+// factor scales refraction computed for the standard atmosphere.
+func (a Atmosphere) factor() float64 {
+	return (a.Pressure / 1010) * (283 / (273 + a.Temperature))
+}
+
+// Bennett returns atmospheric refraction (radians) for the apparent
+// altitude h0 (radians), i.e. the amount to subtract to obtain the true
+// altitude (Meeus, formula 16.3).
+func Bennett(h0 float64, atm Atmosphere) float64 {
+	h := math.Max(mathutils.Degrees(h0), minRefractionAlt)
+	r := 1 / math.Tan(mathutils.Radians(h+7.31/(h+4.4))) // arcminutes
+	return mathutils.Radians(r/60) * atm.factor()
+}
+
+// Saemundsson returns atmospheric refraction (radians) for the true
+// altitude h (radians), i.e. the amount to add to obtain the apparent
+// altitude (Meeus, formula 16.4).
+func Saemundsson(h float64, atm Atmosphere) float64 {
+	d := math.Max(mathutils.Degrees(h), minRefractionAlt)
+	r := 1.02 / math.Tan(mathutils.Radians(d+10.3/(d+5.11))) // arcminutes
+	return mathutils.Radians(r/60) * atm.factor()
+}
@@ -0,0 +1,36 @@
+package earth_test
+
+import (
+	"testing"
+
+	"github.com/ilbagatto/vsop87-go/earth"
+	"github.com/ilbagatto/vsop87-go/mathutils"
+)
+
+func TestBennett(t *testing.T) {
+	// Meeus, p.107: apparent altitude 0°30′ gives refraction 28′44″
+	got := mathutils.Degrees(earth.Bennett(mathutils.Radians(0.5), earth.StandardAtmosphere)) * 60
+	exp := 28.754
+	if !mathutils.AlmostEqual(got, exp, 0.1) {
+		t.Errorf("Refraction should be %.3f′. Got: %.3f′", exp, got)
+	}
+}
+
+func TestSaemundssonInvertsBennett(t *testing.T) {
+	// the two formulae are consistent to a few arcseconds
+	atm := earth.Atmosphere{Pressure: 980, Temperature: -5}
+	for _, deg := range []float64{0, 1, 5, 15, 45, 89} {
+		h0 := mathutils.Radians(deg)
+		h := h0 - earth.Bennett(h0, atm)
+		got := mathutils.Degrees(h+earth.Saemundsson(h, atm)) * 3600
+		if !mathutils.AlmostEqual(got, deg*3600, 10) {
+			t.Errorf("%g°: round trip gives %.1f″", deg, got-deg*3600)
+		}
+	}
+}
+
+func TestNoAtmosphere(t *testing.T) {
+	if got := earth.Saemundsson(0, earth.Atmosphere{}); got != 0 {
+		t.Errorf("Refraction in vacuum should be 0. Got: %g", got)
+	}
+}
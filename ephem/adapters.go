@@ -5,28 +5,99 @@ import (
 	"github.com/ilbagatto/vsop87-go/internal/moon"
 	"github.com/ilbagatto/vsop87-go/internal/pluto"
 	"github.com/ilbagatto/vsop87-go/internal/sun"
+	"github.com/ilbagatto/vsop87-go/mathutils"
 )
 
+// reducer is implemented by the built-in computers, which support every
+// reduction level and frame. Those depending on Earth's heliocentric position take it
+// from the instant, so that it is shared by all bodies.
+type reducer interface {
+	reduce(in *Instant, red Reduction, frame Frame, deltaPsi float64) EclCoord
+}
+
+// lightTimer is implemented by the built-in planetary computers, which can
+// report the heliocentric position of the body at the moment the light left
+// it, and the distance the light travelled.
+type lightTimer interface {
+	lightTimeLBR(in *Instant) (helio mathutils.Spherical, delta float64)
+}
+
+// stateReducer is implemented by the built-in computers whose rates are
+// obtained analytically from the VSOP87 series.
+type stateReducer interface {
+	state(in *Instant, red Reduction, frame Frame) (EclCoord, EclVelocity)
+}
+
+// nutationFunc returns Δψ dt days after the instant, or nil if positions
+// reduced as red and referred to frame do not include nutation.
+func nutationFunc(in *Instant, red Reduction, frame Frame) func(dt float64) float64 {
+	if red != Apparent || frame.Resolve(red) != FrameOfDate {
+		return nil
+	}
+	return func(dt float64) float64 {
+		at := in
+		if dt != 0 {
+			at = in.Offset(dt)
+		}
+		deltaPsi, _ := at.Nutation()
+		return deltaPsi
+	}
+}
+
 type vsopPlanet struct{ hc heliocentric.Heliocentric }
 
+func (p vsopPlanet) lightTimeLBR(in *Instant) (mathutils.Spherical, float64) {
+	return heliocentric.LightTimeLBR(in.JD(), p.hc, in.EarthLBR())
+}
+
 func (p vsopPlanet) Compute(jd, deltaPsi float64) EclCoord {
 	return heliocentric.ApparentGeocentric(jd, p.hc, deltaPsi)
 }
 
+func (p vsopPlanet) reduce(in *Instant, red Reduction, frame Frame, deltaPsi float64) EclCoord {
+	return heliocentric.GeocentricFrom(in.JD(), p.hc, red, frame, deltaPsi, in.EarthLBR())
+}
+
+func (p vsopPlanet) state(in *Instant, red Reduction, frame Frame) (EclCoord, EclVelocity) {
+	pos, rate := heliocentric.GeocentricStateFrom(in.JD(), p.hc, red, frame, nutationFunc(in, red, frame), in.EarthLBR(), in.EarthRate())
+	return pos, EclVelocity(rate)
+}
+
 type moonWrapper struct{}
 
 func (moonWrapper) Compute(jd, deltaPsi float64) EclCoord {
 	return moon.Apparent(jd, deltaPsi)
 }
 
+func (moonWrapper) reduce(in *Instant, red Reduction, frame Frame, deltaPsi float64) EclCoord {
+	return moon.Position(in.JD(), red, frame, deltaPsi)
+}
+
 type sunWrapper struct{}
 
 func (sunWrapper) Compute(jd, deltaPsi float64) EclCoord {
 	return sun.Apparent(jd, deltaPsi)
 }
 
+func (sunWrapper) reduce(in *Instant, red Reduction, frame Frame, deltaPsi float64) EclCoord {
+	return sun.PositionFrom(in.JD(), red, frame, deltaPsi, in.EarthLBR())
+}
+
+func (sunWrapper) state(in *Instant, red Reduction, frame Frame) (EclCoord, EclVelocity) {
+	pos, rate := sun.StateFrom(in.JD(), red, frame, nutationFunc(in, red, frame), in.EarthLBR(), in.EarthRate())
+	return pos, EclVelocity(rate)
+}
+
 type plutoWrapper struct{}
 
 func (plutoWrapper) Compute(jd, deltaPsi float64) EclCoord {
 	return pluto.Apparent(jd, deltaPsi)
 }
+
+func (plutoWrapper) lightTimeLBR(in *Instant) (mathutils.Spherical, float64) {
+	return pluto.LightTimeLBR(in.JD())
+}
+
+func (plutoWrapper) reduce(in *Instant, red Reduction, frame Frame, deltaPsi float64) EclCoord {
+	return pluto.Position(in.JD(), red, frame, deltaPsi)
+}
@@ -0,0 +1,177 @@
+package ephem
+
+import (
+	"math"
+
+	"github.com/ilbagatto/vsop87-go/internal/heliocentric"
+	"github.com/ilbagatto/vsop87-go/internal/search"
+	"github.com/ilbagatto/vsop87-go/utils"
+)
+
+// ApsisType is the kind of an extremum of distance.
+type ApsisType int
+
+const (
+	// Perigee: the Moon is closest to the Earth.
+	Perigee ApsisType = iota
+	// Apogee: the Moon is farthest from the Earth.
+	Apogee
+	// Perihelion: a planet is closest to the Sun.
+	Perihelion
+	// Aphelion: a planet is farthest from the Sun.
+	Aphelion
+)
+
+var apsisNames = [...]string{"perigee", "apogee", "perihelion", "aphelion"}
+
+func (t ApsisType) String() string {
+	if t < 0 || int(t) >= len(apsisNames) {
+		return "unknown"
+	}
+	return apsisNames[t]
+}
+
+// Apsis is a moment of least or greatest distance.
+type Apsis struct {
+	JD         float64 // Julian Day (TT)
+	Type       ApsisType
+	Distance   float64 // AU
+	DistanceKm float64 // km
+
+	// Syzygy is the New or Full Moon nearest to a lunar apsis, if it falls
+	// within the window passed to LunarApsides; its JD is zero otherwise.
+	Syzygy LunarPhaseEvent
+}
+
+// Supermoon reports whether a perigee coincides with a New or Full Moon.
+func (a Apsis) Supermoon() bool {
+	return a.Type == Perigee && a.Syzygy.JD != 0
+}
+
+// Micromoon reports whether an apogee coincides with a New or Full Moon.
+func (a Apsis) Micromoon() bool {
+	return a.Type == Apogee && a.Syzygy.JD != 0
+}
+
+// LunarApsides returns the perigees and apogees of the Moon between start
+// and end (Julian Days, TT), in chronological order. If window is positive,
+// each apsis is paired with the New or Full Moon less than window days
+// away, which flags supermoons and micromoons.
+func LunarApsides(start, end, window float64) ([]Apsis, error) {
+	return defaultEphemeris.LunarApsides(start, end, window)
+}
+
+// LunarApsides returns the perigees and apogees of the Moon between start
+// and end, using the ephemeris. The apsides are the zeros of the
+// geocentric range rate rather than the mean values of Meeus, ch. 50.
+func (e *Ephemeris) LunarApsides(start, end, window float64) ([]Apsis, error) {
+	rate := func(jd float64) (float64, error) {
+		_, vel, err := e.EclipticStateAt(Moon, e.NewInstant(jd))
+		return vel.Radius, err
+	}
+	// apsides are about two weeks apart
+	roots, err := search.Roots(rate, start, end, 1, crossingTol, 0)
+	if err != nil {
+		return nil, err
+	}
+	var syzygies []LunarPhaseEvent
+	if window > 0 {
+		phases, err := e.LunarPhases(start-window, end+window)
+		if err != nil {
+			return nil, err
+		}
+		for _, p := range phases {
+			if p.Phase == NewMoon || p.Phase == FullMoon {
+				syzygies = append(syzygies, p)
+			}
+		}
+	}
+
+	res := make([]Apsis, len(roots))
+	for i, r := range roots {
+		pos, err := e.EclipticPositionAt(Moon, e.NewInstant(r.JD))
+		if err != nil {
+			return nil, err
+		}
+		res[i] = newApsis(r, pos.Radius, Perigee, Apogee)
+		for _, s := range syzygies {
+			if d := math.Abs(s.JD - r.JD); d < window && (res[i].Syzygy.JD == 0 || d < math.Abs(res[i].Syzygy.JD-r.JD)) {
+				res[i].Syzygy = s
+			}
+		}
+	}
+	return res, nil
+}
+
+// PlanetApsides returns the perihelia and aphelia of a planet between start
+// and end (Julian Days, TT), in chronological order, from its VSOP87 radius
+// vector. The Sun stands for the Earth. Pluto, the Moon and custom backends
+// yield an *UnsupportedBodyError.
+func PlanetApsides(body Body, start, end float64) ([]Apsis, error) {
+	return defaultEphemeris.PlanetApsides(body, start, end)
+}
+
+// PlanetApsides returns the perihelia and aphelia of a planet between start
+// and end, using the series of the ephemeris.
+func (e *Ephemeris) PlanetApsides(body Body, start, end float64) ([]Apsis, error) {
+	var hc heliocentric.Heliocentric
+	if body == Sun {
+		hc = e.earth
+	} else {
+		comp, err := e.computer(body)
+		if err != nil {
+			return nil, err
+		}
+		p, ok := comp.(vsopPlanet)
+		if !ok {
+			return nil, &UnsupportedBodyError{Body: body, Op: "apsides"}
+		}
+		hc = p.hc
+	}
+	rate := func(jd float64) (float64, error) {
+		_, r := heliocentric.LBRWithRate(jd, hc)
+		return r.R, nil
+	}
+	roots, err := search.Roots(rate, start, end, apsisStep(body), crossingTol, 0)
+	if err != nil {
+		return nil, err
+	}
+	res := make([]Apsis, len(roots))
+	for i, r := range roots {
+		res[i] = newApsis(r, heliocentric.LBR(r.JD, hc).R, Perihelion, Aphelion)
+	}
+	return res, nil
+}
+
+// newApsis returns the apsis at a zero of the range rate: a rising zero is
+// a minimum of distance.
+func newApsis(r search.Root, dist float64, near, far ApsisType) Apsis {
+	a := Apsis{JD: r.JD, Type: far, Distance: dist, DistanceKm: utils.AuToKm(dist)}
+	if r.Rising {
+		a.Type = near
+	}
+	return a
+}
+
+// apsisStep returns the scan step, in days: about a twentieth of the
+// orbital period.
+func apsisStep(body Body) float64 {
+	switch body {
+	case Mercury:
+		return 4
+	case Venus:
+		return 10
+	case Sun:
+		return 15
+	case Mars:
+		return 30
+	case Jupiter:
+		return 200
+	case Saturn:
+		return 500
+	case Uranus:
+		return 1500
+	default:
+		return 3000
+	}
+}
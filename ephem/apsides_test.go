@@ -0,0 +1,75 @@
+package ephem
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/ilbagatto/vsop87-go/mathutils"
+)
+
+func TestLunarApsides(t *testing.T) {
+	// Meeus, example 50.a: apogee at JDE 2447442.3543, parallax 3240.679″
+	got, err := LunarApsides(2447430.5, 2447450.5, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != 1 || got[0].Type != Apogee {
+		t.Fatalf("Expected a single apogee. Got: %v", got)
+	}
+	if math.Abs(got[0].JD-2447442.3543) > 0.005 {
+		t.Errorf("Apogee should be at 2447442.3543. Got: %.4f", got[0].JD)
+	}
+	if !mathutils.AlmostEqual(got[0].DistanceKm, 405976.6, 5) {
+		t.Errorf("Distance should be 405976.6 km. Got: %.1f", got[0].DistanceKm)
+	}
+}
+
+func TestSupermoon(t *testing.T) {
+	// 2024 September 18: perigee at 13:22 UT, Full Moon at 02:34 UT
+	got, err := LunarApsides(2460560.5, 2460600.5, 1.5)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != 2 {
+		t.Fatalf("Expected 2 apsides. Got: %v", got)
+	}
+	if !got[0].Supermoon() || got[0].Syzygy.Phase != FullMoon {
+		t.Errorf("Perigee of September 18 should be a supermoon. Got: %+v", got[0])
+	}
+	if got[1].Type != Apogee || !got[1].Micromoon() || got[1].Syzygy.Phase != NewMoon {
+		t.Errorf("Apogee of October 2 should coincide with New Moon. Got: %+v", got[1])
+	}
+}
+
+func TestPlanetApsides(t *testing.T) {
+	// Earth in 2024: perihelion January 3, 0:39 UT, 0.983307 AU;
+	// aphelion July 5, 5:06 UT, 1.016725 AU
+	exp := []struct {
+		typ      ApsisType
+		jd, dist float64
+	}{
+		{Perihelion, 2460312.5278, 0.983307},
+		{Aphelion, 2460496.7133, 1.016725},
+	}
+	got, err := PlanetApsides(Sun, 2460300.5, 2460500.5)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != len(exp) {
+		t.Fatalf("Expected %d apsides. Got: %v", len(exp), got)
+	}
+	for i, a := range got {
+		if a.Type != exp[i].typ || math.Abs(a.JD-exp[i].jd) > 0.01 || !mathutils.AlmostEqual(a.Distance, exp[i].dist, 1e-6) {
+			t.Errorf("Apsis #%d should be %s at %.4f (%.6f AU). Got: %+v", i, exp[i].typ, exp[i].jd, exp[i].dist, a)
+		}
+	}
+
+	for _, b := range []Body{Moon, Pluto} {
+		_, err := PlanetApsides(b, 2451545.0, 2451910.0)
+		var ube *UnsupportedBodyError
+		if !errors.As(err, &ube) {
+			t.Errorf("%s: expected UnsupportedBodyError. Got: %v", b, err)
+		}
+	}
+}
@@ -0,0 +1,144 @@
+package ephem
+
+import (
+	"math"
+	"slices"
+
+	"github.com/ilbagatto/vsop87-go/internal/search"
+	"github.com/ilbagatto/vsop87-go/mathutils"
+)
+
+// MajorAspects are the conjunction, sextile, square, trine and opposition,
+// in radians.
+var MajorAspects = []float64{0, math.Pi / 3, math.Pi / 2, 2 * math.Pi / 3, math.Pi}
+
+// Aspect is a moment when the difference in longitude between two bodies,
+// or a body and a fixed point, equals an aspect angle.
+type Aspect struct {
+	JD    float64 // Julian Day (TT)
+	Angle float64 // aspect angle, radians in [0, π]
+	Diff  float64 // difference in longitude λ1 − λ2, radians in [0, 2π)
+}
+
+// longitudeFunc returns the longitude and the longitude speed of a point.
+type longitudeFunc func(jd float64) (lambda, speed float64, err error)
+
+// Aspects returns all moments between start and end (Julian Days, TT) when
+// the difference in longitude of two bodies equals one of the angles
+// (radians), on either side, in chronological order. Retrograde motion may
+// bring the same aspect several times.
+func Aspects(a, b Body, start, end float64, angles ...float64) ([]Aspect, error) {
+	return defaultEphemeris.Aspects(a, b, start, end, angles...)
+}
+
+// Aspects returns all moments between start and end when the difference in
+// longitude of two bodies equals one of the angles, using the reduction and
+// frame of the ephemeris.
+func (e *Ephemeris) Aspects(a, b Body, start, end float64, angles ...float64) ([]Aspect, error) {
+	if a == b {
+		return nil, &UnsupportedBodyError{Body: b, Op: "aspects"}
+	}
+	for _, body := range []Body{a, b} {
+		if _, err := e.computer(body); err != nil {
+			return nil, err
+		}
+	}
+	step := math.Min(crossingStep(a), crossingStep(b))
+	return aspects(e.bodyLongitude(a), e.bodyLongitude(b), start, end, step, angles)
+}
+
+// AspectsToLongitude returns all moments between start and end (Julian
+// Days, TT) when the difference between the longitude of a body and a fixed
+// longitude lambda (radians) equals one of the angles.
+func AspectsToLongitude(body Body, lambda, start, end float64, angles ...float64) ([]Aspect, error) {
+	return defaultEphemeris.AspectsToLongitude(body, lambda, start, end, angles...)
+}
+
+// AspectsToLongitude returns all moments between start and end when the
+// difference between the longitude of a body and a fixed longitude equals
+// one of the angles, using the reduction and frame of the ephemeris.
+func (e *Ephemeris) AspectsToLongitude(body Body, lambda, start, end float64, angles ...float64) ([]Aspect, error) {
+	if _, err := e.computer(body); err != nil {
+		return nil, err
+	}
+	fixed := func(float64) (float64, float64, error) {
+		return lambda, 0, nil
+	}
+	return aspects(e.bodyLongitude(body), fixed, start, end, crossingStep(body), angles)
+}
+
+// bodyLongitude returns the longitude function of a body.
+func (e *Ephemeris) bodyLongitude(body Body) longitudeFunc {
+	return func(jd float64) (float64, float64, error) {
+		pos, v, err := e.EclipticPositionWithVelocityAt(body, e.NewInstant(jd))
+		return pos.Lambda, v, err
+	}
+}
+
+// aspects finds the moments when λ1 − λ2 equals ±angle. The difference is
+// monotonic between the zeros of the relative speed, which are found first
+// and used to split the range, so that no repeated hit is lost.
+func aspects(f1, f2 longitudeFunc, start, end, step float64, angles []float64) ([]Aspect, error) {
+	relSpeed := func(jd float64) (float64, error) {
+		_, v1, err := f1(jd)
+		if err != nil {
+			return 0, err
+		}
+		_, v2, err := f2(jd)
+		return v1 - v2, err
+	}
+	turns, err := search.Roots(relSpeed, start, end, step/5, stationTol, 0)
+	if err != nil {
+		return nil, err
+	}
+	breaks := make([]float64, len(turns))
+	for i, r := range turns {
+		breaks[i] = r.JD
+	}
+
+	diff := func(jd float64) (float64, error) {
+		l1, _, err := f1(jd)
+		if err != nil {
+			return 0, err
+		}
+		l2, _, err := f2(jd)
+		return l1 - l2, err
+	}
+	times := search.Grid(start, end, step, breaks...)
+	res, err := search.AngleCrossings(diff, aspectTargets(angles), times, crossingTol)
+	if err != nil {
+		return nil, err
+	}
+	hits := make([]Aspect, len(res))
+	for i, c := range res {
+		hits[i] = Aspect{JD: c.JD, Angle: math.Abs(c.Target), Diff: mathutils.ReduceRad(c.Target)}
+	}
+	return hits, nil
+}
+
+// aspectTargets returns the differences in longitude, in (−π, π], at which
+// the aspects are exact: +angle and −angle, once for 0 and π. Equivalent
+// angles, such as π/3 and 5π/3, yield their targets once.
+func aspectTargets(angles []float64) []float64 {
+	norm := make([]float64, len(angles))
+	for i, a := range angles {
+		norm[i] = math.Abs(math.Remainder(a, Pi2))
+	}
+	slices.Sort(norm)
+	norm = slices.CompactFunc(norm, func(a, b float64) bool {
+		return math.Abs(a-b) < aspectTol
+	})
+
+	var targets []float64
+	for _, a := range norm {
+		targets = append(targets, a)
+		if a > aspectTol && a < math.Pi-aspectTol {
+			targets = append(targets, -a)
+		}
+	}
+	return targets
+}
+
+// aspectTol is the tolerance, in radians, within which two aspect angles
+// are taken for the same.
+const aspectTol = 1e-9
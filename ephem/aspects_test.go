@@ -0,0 +1,82 @@
+package ephem
+
+import (
+	"math"
+	"testing"
+
+	"github.com/ilbagatto/vsop87-go/mathutils"
+)
+
+func TestAspectsMoonSun(t *testing.T) {
+	// New Moon 2024 January 11, 11:57 UT; Full Moon January 25, 17:54 UT
+	exp := []Aspect{
+		{JD: 2460320.9988, Angle: 0, Diff: 0},
+		{JD: 2460335.2466, Angle: math.Pi, Diff: math.Pi},
+	}
+	got, err := Aspects(Moon, Sun, 2460310.5, 2460341.5, 0, math.Pi)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != len(exp) {
+		t.Fatalf("Expected %d aspects. Got: %v", len(exp), got)
+	}
+	for i, a := range got {
+		if math.Abs(a.JD-exp[i].JD) > 1.0/1440 || a.Angle != exp[i].Angle || a.Diff != exp[i].Diff {
+			t.Errorf("Aspect #%d should be %+v. Got: %+v", i, exp[i], a)
+		}
+	}
+}
+
+func TestAspectsRetrograde(t *testing.T) {
+	// Mercury retrograde from 27° to 16° Aries in April 2024
+	lambda := mathutils.Radians(20)
+	got, err := AspectsToLongitude(Mercury, lambda, 2460370.5, 2460450.5, MajorAspects...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != 3 {
+		t.Fatalf("Expected 3 conjunctions. Got: %v", got)
+	}
+	for i, a := range got {
+		p, _ := EclipticPositionAt(Mercury, NewInstant(a.JD))
+		if d := math.Remainder(p.Lambda-lambda-a.Diff, Pi2); math.Abs(d) > 1e-7 || a.Angle != 0 {
+			t.Errorf("Aspect #%d: difference off by %g rad", i, d)
+		}
+	}
+}
+
+func TestAspectsSameBody(t *testing.T) {
+	if _, err := Aspects(Mars, Mars, 2451545.0, 2451910.0, MajorAspects...); err == nil {
+		t.Errorf("Aspects of a body with itself should fail")
+	}
+}
+
+func TestAspectTargets(t *testing.T) {
+	got := aspectTargets([]float64{0, math.Pi / 3, -math.Pi / 2, math.Pi, 5 * math.Pi / 3})
+	// 5π/3 is the same aspect as π/3, and −π/2 as π/2
+	exp := []float64{0, math.Pi / 3, -math.Pi / 3, math.Pi / 2, -math.Pi / 2, math.Pi}
+	if len(got) != len(exp) {
+		t.Fatalf("Targets should be %v. Got: %v", exp, got)
+	}
+	for i := range got {
+		if !mathutils.AlmostEqual(got[i], exp[i], 1e-12) {
+			t.Errorf("Targets should be %v. Got: %v", exp, got)
+			break
+		}
+	}
+}
+
+func TestAspectsEquivalentAngles(t *testing.T) {
+	const start, end = 2451545.0, 2452545.0
+	once, err := AspectsToLongitude(Mars, 0, start, end, math.Pi/3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	twice, err := AspectsToLongitude(Mars, 0, start, end, math.Pi/3, 5*math.Pi/3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(once) == 0 || len(twice) != len(once) {
+		t.Errorf("±60° should yield the %d sextiles once. Got: %v", len(once), twice)
+	}
+}
@@ -0,0 +1,143 @@
+package ephem
+
+import (
+	"context"
+	"errors"
+	"iter"
+	"math"
+	"runtime"
+	"sync"
+)
+
+// rowsPerWorker is the number of rows each worker computes per chunk.
+// Rows are produced chunk by chunk, which keeps memory bounded when
+// streaming long ranges.
+const rowsPerWorker = 16
+
+// BatchRequest describes a table of apparent ecliptic positions.
+type BatchRequest struct {
+	Bodies  []Body  // bodies, in the order of BatchRow.Positions
+	Start   float64 // JD(TT) of the first row
+	End     float64 // JD(TT) of the last row (inclusive)
+	Step    float64 // interval between rows, days
+	Workers int     // number of goroutines; <= 0 means runtime.GOMAXPROCS(0)
+}
+
+// BatchRow holds positions of all requested bodies at one instant.
+type BatchRow struct {
+	JD        float64    // JD(TT)
+	Positions []EclCoord // in the order of BatchRequest.Bodies
+}
+
+// Batch computes the whole table described by req. Rows are returned in
+// chronological order, and the result does not depend on the number of
+// workers.
+func Batch(ctx context.Context, req BatchRequest) ([]BatchRow, error) {
+	return defaultEphemeris.Batch(ctx, req)
+}
+
+// Batch computes the whole table described by req in chronological order.
+func (e *Ephemeris) Batch(ctx context.Context, req BatchRequest) ([]BatchRow, error) {
+	var rows []BatchRow
+	for row, err := range e.BatchSeq(ctx, req) {
+		if err != nil {
+			return nil, err
+		}
+		rows = append(rows, row)
+	}
+	return rows, nil
+}
+
+// BatchSeq streams the table described by req in chronological order.
+// Each row is computed from a single Instant, so nutation and Earth's
+// heliocentric position are shared by all bodies; rows are spread across
+// req.Workers goroutines.
+//
+// Iteration stops when the consumer breaks out of the loop or ctx is
+// cancelled; in the latter case the last pair carries ctx.Err(). The
+// workers check ctx before each row, so cancellation takes effect within
+// one row per worker.
+func BatchSeq(ctx context.Context, req BatchRequest) iter.Seq2[BatchRow, error] {
+	return defaultEphemeris.BatchSeq(ctx, req)
+}
+
+// BatchSeq streams the table described by req in chronological order.
+func (e *Ephemeris) BatchSeq(ctx context.Context, req BatchRequest) iter.Seq2[BatchRow, error] {
+	return func(yield func(BatchRow, error) bool) {
+		n, err := e.validate(req)
+		if err != nil {
+			yield(BatchRow{}, err)
+			return
+		}
+		workers := req.Workers
+		if workers <= 0 {
+			workers = runtime.GOMAXPROCS(0)
+		}
+		chunk := make([]BatchRow, workers*rowsPerWorker)
+
+		for first := 0; first < n; first += len(chunk) {
+			if err := ctx.Err(); err != nil {
+				yield(BatchRow{}, err)
+				return
+			}
+			rows := chunk[:min(len(chunk), n-first)]
+			if err := e.computeChunk(ctx, req, rows, first, workers); err != nil {
+				yield(BatchRow{}, err)
+				return
+			}
+			for _, row := range rows {
+				if !yield(row, nil) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// validate checks the request and returns the number of rows.
+func (e *Ephemeris) validate(req BatchRequest) (int, error) {
+	if !(req.Step > 0) {
+		return 0, errors.New("ephem: batch step must be positive")
+	}
+	if req.End < req.Start {
+		return 0, errors.New("ephem: batch end precedes start")
+	}
+	for _, b := range req.Bodies {
+		if _, err := e.computer(b); err != nil {
+			return 0, err
+		}
+	}
+	// tolerate rounding so that End is included when it is on the grid
+	return int(math.Floor((req.End-req.Start)/req.Step+1e-9)) + 1, nil
+}
+
+// computeChunk fills rows with the rows first, first+1, … using workers goroutines.
+// The workers check ctx before each row and stop once it is cancelled.
+func (e *Ephemeris) computeChunk(ctx context.Context, req BatchRequest, rows []BatchRow, first, workers int) error {
+	var wg sync.WaitGroup
+	for w := range min(workers, len(rows)) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := w; i < len(rows); i += workers {
+				if ctx.Err() != nil {
+					return
+				}
+				rows[i] = e.row(req, req.Start+float64(first+i)*req.Step)
+			}
+		}()
+	}
+	wg.Wait()
+	return ctx.Err()
+}
+
+// row computes positions of all bodies at jd, sharing per-instant quantities.
+func (e *Ephemeris) row(req BatchRequest, jd float64) BatchRow {
+	in := e.NewInstant(jd)
+	pos := make([]EclCoord, len(req.Bodies))
+	for i, b := range req.Bodies {
+		// bodies were validated by the caller
+		pos[i], _ = e.EclipticPositionAt(b, in)
+	}
+	return BatchRow{JD: jd, Positions: pos}
+}
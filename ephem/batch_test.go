@@ -0,0 +1,131 @@
+package ephem
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+
+	"github.com/ilbagatto/vsop87-go/earth"
+)
+
+var batchBodies = []Body{Moon, Sun, Mercury, Venus, Mars, Jupiter, Saturn, Uranus, Neptune, Pluto}
+
+func TestBatchMatchesEclipticPosition(t *testing.T) {
+	req := BatchRequest{Bodies: batchBodies, Start: 2451545.0, End: 2451555.0, Step: 0.5, Workers: 3}
+	rows, err := Batch(context.Background(), req)
+	if err != nil {
+		t.Fatalf("Batch: %v", err)
+	}
+	if len(rows) != 21 {
+		t.Fatalf("Expected 21 rows, got %d", len(rows))
+	}
+	for i, row := range rows {
+		if exp := req.Start + float64(i)*req.Step; row.JD != exp {
+			t.Errorf("row %d: JD should be %.1f. Got: %.1f", i, exp, row.JD)
+		}
+		deltaPsi, _ := earth.Nutation(row.JD)
+		for j, b := range req.Bodies {
+			exp, _ := EclipticPosition(b, row.JD, deltaPsi)
+			if !sameEcl(row.Positions[j], exp) {
+				t.Errorf("row %d, %s: %+v != %+v", i, b, row.Positions[j], exp)
+			}
+		}
+	}
+}
+
+func TestBatchDeterministic(t *testing.T) {
+	req := BatchRequest{Bodies: batchBodies, Start: 2451545.0, End: 2451645.0, Step: 1, Workers: 1}
+	exp, err := Batch(context.Background(), req)
+	if err != nil {
+		t.Fatalf("Batch: %v", err)
+	}
+	req.Workers = 7
+	got, err := Batch(context.Background(), req)
+	if err != nil {
+		t.Fatalf("Batch: %v", err)
+	}
+	if len(got) != len(exp) {
+		t.Fatalf("Expected %d rows, got %d", len(exp), len(got))
+	}
+	for i := range exp {
+		for j := range exp[i].Positions {
+			if got[i].Positions[j] != exp[i].Positions[j] {
+				t.Fatalf("row %d, body %d differs between worker counts", i, j)
+			}
+		}
+	}
+}
+
+func TestBatchSeqCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	req := BatchRequest{Bodies: []Body{Sun}, Start: 2451545.0, End: 2461545.0, Step: 1, Workers: 2}
+	var n int
+	var lastErr error
+	for _, err := range BatchSeq(ctx, req) {
+		if err != nil {
+			lastErr = err
+			break
+		}
+		n++
+		if n == 10 {
+			cancel()
+		}
+	}
+	if lastErr != context.Canceled {
+		t.Errorf("Expected context.Canceled, got %v", lastErr)
+	}
+	if n >= 10000 {
+		t.Errorf("Iteration was not cancelled")
+	}
+}
+
+// cancellingComputer cancels the context on its first call.
+type cancellingComputer struct {
+	cancel context.CancelFunc
+	calls  *atomic.Int32
+}
+
+func (c cancellingComputer) Compute(jd, deltaPsi float64) EclCoord {
+	c.calls.Add(1)
+	c.cancel()
+	return EclCoord{Radius: 1}
+}
+
+func TestBatchSeqCancelWithinChunk(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	var calls atomic.Int32
+	e := New(WithBackend(Mars, cancellingComputer{cancel, &calls}))
+	req := BatchRequest{Bodies: []Body{Mars}, Start: 2451545.0, End: 2451545.0 + 99, Step: 1, Workers: 2}
+	var n int
+	var lastErr error
+	for _, err := range e.BatchSeq(ctx, req) {
+		if err != nil {
+			lastErr = err
+			break
+		}
+		n++
+	}
+	if lastErr != context.Canceled {
+		t.Errorf("Expected context.Canceled, got %v", lastErr)
+	}
+	if n != 0 {
+		t.Errorf("Expected no rows, got %d", n)
+	}
+	if got := calls.Load(); got > int32(req.Workers) {
+		t.Errorf("Expected at most %d rows computed after cancellation, got %d", req.Workers, got)
+	}
+}
+
+func TestBatchInvalid(t *testing.T) {
+	for _, req := range []BatchRequest{
+		{Bodies: []Body{Sun}, Start: 0, End: 1, Step: 0},
+		{Bodies: []Body{Sun}, Start: 1, End: 0, Step: 1},
+		{Bodies: []Body{Body(42)}, Start: 0, End: 1, Step: 1},
+	} {
+		if _, err := Batch(context.Background(), req); err == nil {
+			t.Errorf("Expected error for %+v", req)
+		}
+	}
+}
@@ -0,0 +1,168 @@
+package ephem
+
+import (
+	"math"
+
+	"github.com/ilbagatto/vsop87-go/internal/moon"
+	"github.com/ilbagatto/vsop87-go/internal/search"
+)
+
+// crossingTol is the accuracy of crossing times, in days (about 1 s).
+const crossingTol = 1e-5
+
+// Crossing is a moment when a body passes an ecliptic longitude.
+type Crossing struct {
+	JD         float64 // Julian Day (TT)
+	Lambda     float64 // target longitude, radians
+	Retrograde bool    // the longitude decreases
+}
+
+// Ingress is a moment when a body enters a zodiac sign.
+type Ingress struct {
+	JD         float64 // Julian Day (TT)
+	Sign       int     // index of the sign entered, see utils.Zodiac
+	Retrograde bool    // the body enters the sign moving backwards
+}
+
+// Crossings returns all moments between start and end (Julian Days, TT)
+// when the geocentric longitude of a body passes any of the target
+// longitudes (radians), in chronological order. During a retrograde loop a
+// planet may pass the same longitude three times.
+func Crossings(body Body, start, end float64, targets ...float64) ([]Crossing, error) {
+	return defaultEphemeris.Crossings(body, start, end, targets...)
+}
+
+// Crossings returns all moments between start and end when the longitude
+// of a body passes any of the targets, using the reduction and frame of
+// the ephemeris.
+func (e *Ephemeris) Crossings(body Body, start, end float64, targets ...float64) ([]Crossing, error) {
+	if _, err := e.computer(body); err != nil {
+		return nil, err
+	}
+	// the longitude is monotonic between stations
+	var breaks []float64
+	if body != Sun && body != Moon {
+		stations, err := e.Stations(body, start, end)
+		if err != nil {
+			return nil, err
+		}
+		for _, s := range stations {
+			breaks = append(breaks, s.JD)
+		}
+	}
+	lambda := func(jd float64) (float64, error) {
+		pos, err := e.EclipticPositionAt(body, e.NewInstant(jd))
+		return pos.Lambda, err
+	}
+	times := search.Grid(start, end, crossingStep(body), breaks...)
+	res, err := search.AngleCrossings(lambda, targets, times, crossingTol)
+	if err != nil {
+		return nil, err
+	}
+	return toCrossings(res), nil
+}
+
+// Ingresses returns all moments between start and end (Julian Days, TT)
+// when a body enters a zodiac sign, in chronological order.
+func Ingresses(body Body, start, end float64) ([]Ingress, error) {
+	return defaultEphemeris.Ingresses(body, start, end)
+}
+
+// Ingresses returns all moments between start and end when a body enters
+// a zodiac sign, using the reduction and frame of the ephemeris.
+func (e *Ephemeris) Ingresses(body Body, start, end float64) ([]Ingress, error) {
+	crossings, err := e.Crossings(body, start, end, signCusps()...)
+	if err != nil {
+		return nil, err
+	}
+	return toIngresses(crossings), nil
+}
+
+// NodeCrossings returns all moments between start and end (Julian Days,
+// TT) when the Moon's mean (trueNode=false) or true (trueNode=true)
+// ascending node passes any of the target longitudes (radians).
+func NodeCrossings(trueNode bool, start, end float64, targets ...float64) ([]Crossing, error) {
+	node := func(jd float64) (float64, error) {
+		return moon.Node(jd, trueNode), nil
+	}
+	// the true node oscillates around the mean one and briefly turns
+	// direct; its longitude is monotonic between stations
+	var breaks []float64
+	if trueNode {
+		rate := func(jd float64) (float64, error) {
+			_, speed := NodePositionWithVelocity(jd, true)
+			return speed, nil
+		}
+		roots, err := search.Roots(rate, start, end, nodeStationStep, stationTol, 0)
+		if err != nil {
+			return nil, err
+		}
+		for _, r := range roots {
+			breaks = append(breaks, r.JD)
+		}
+	}
+	res, err := search.AngleCrossings(node, targets, search.Grid(start, end, 10, breaks...), crossingTol)
+	if err != nil {
+		return nil, err
+	}
+	return toCrossings(res), nil
+}
+
+// nodeStationStep is the sampling step of the true node's speed, in days.
+// The speed oscillates with half-month periods, and its direct stretches
+// last a few days.
+const nodeStationStep = 0.5
+
+// NodeIngresses returns all moments between start and end (Julian Days,
+// TT) when the Moon's mean or true ascending node enters a zodiac sign.
+func NodeIngresses(trueNode bool, start, end float64) ([]Ingress, error) {
+	crossings, err := NodeCrossings(trueNode, start, end, signCusps()...)
+	if err != nil {
+		return nil, err
+	}
+	return toIngresses(crossings), nil
+}
+
+// crossingStep returns the sampling step, in days. The body must move less
+// than 180° within a step.
+func crossingStep(body Body) float64 {
+	switch body {
+	case Moon:
+		return 1
+	case Mercury, Venus:
+		return 5
+	default:
+		return 10
+	}
+}
+
+// signCusps returns the longitudes of the beginnings of the zodiac signs.
+func signCusps() []float64 {
+	cusps := make([]float64, 12)
+	for i := range cusps {
+		cusps[i] = float64(i) * math.Pi / 6
+	}
+	return cusps
+}
+
+func toCrossings(res []search.Crossing) []Crossing {
+	crossings := make([]Crossing, len(res))
+	for i, c := range res {
+		crossings[i] = Crossing{JD: c.JD, Lambda: c.Target, Retrograde: !c.Increasing}
+	}
+	return crossings
+}
+
+// toIngresses converts crossings of the sign cusps to ingresses. Moving
+// backwards, a body leaving a sign through its cusp enters the previous one.
+func toIngresses(crossings []Crossing) []Ingress {
+	ingresses := make([]Ingress, len(crossings))
+	for i, c := range crossings {
+		sign := int(math.Round(c.Lambda / (math.Pi / 6)))
+		if c.Retrograde {
+			sign = (sign + 11) % 12
+		}
+		ingresses[i] = Ingress{JD: c.JD, Sign: sign, Retrograde: c.Retrograde}
+	}
+	return ingresses
+}
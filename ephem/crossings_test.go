@@ -0,0 +1,108 @@
+package ephem
+
+import (
+	"math"
+	"testing"
+
+	"github.com/ilbagatto/vsop87-go/mathutils"
+)
+
+func TestIngressesSun(t *testing.T) {
+	// 2024 March equinox: March 20, 3:06 UT
+	const equinox = 2460389.6298
+	got, err := Ingresses(Sun, 2460310.5, 2460676.5)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != 12 {
+		t.Fatalf("Expected 12 ingresses. Got: %v", got)
+	}
+	for i, in := range got {
+		// the year starts with the Sun in Capricorn
+		if exp := (10 + i) % 12; in.Sign != exp || in.Retrograde {
+			t.Errorf("Ingress #%d should be direct into sign %d. Got: %+v", i, exp, in)
+		}
+	}
+	if math.Abs(got[2].JD-equinox) > 1.0/1440 {
+		t.Errorf("Ingress into Aries should be at %.4f. Got: %.4f", equinox, got[2].JD)
+	}
+}
+
+func TestCrossingsRetrograde(t *testing.T) {
+	// Mercury retrograde from 27° to 16° Aries in April 2024
+	target := mathutils.Radians(20)
+	got, err := Crossings(Mercury, 2460370.5, 2460450.5, target)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != 3 {
+		t.Fatalf("Expected 3 crossings. Got: %v", got)
+	}
+	for i, c := range got {
+		if exp := i == 1; c.Retrograde != exp {
+			t.Errorf("Crossing #%d retrograde should be %t", i, exp)
+		}
+		pos, _ := EclipticPositionAt(Mercury, NewInstant(c.JD))
+		if d := math.Remainder(pos.Lambda-target, Pi2); math.Abs(d) > 1e-7 {
+			t.Errorf("Crossing #%d: longitude off by %g rad", i, d)
+		}
+	}
+}
+
+func TestNodeIngresses(t *testing.T) {
+	const start, end = 2451545.0, 2451545.0 + 6000
+	got, err := NodeIngresses(false, start, end)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got) < 10 {
+		t.Fatalf("Expected at least 10 ingresses. Got: %v", got)
+	}
+	for i, in := range got {
+		if !in.Retrograde {
+			t.Errorf("Mean node ingress #%d should be retrograde", i)
+		}
+		if i > 0 && in.Sign != (got[i-1].Sign+11)%12 {
+			t.Errorf("Ingress #%d should follow sign %d. Got: %d", i, got[i-1].Sign, in.Sign)
+		}
+	}
+	crossings, err := NodeCrossings(true, start, end, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, c := range crossings {
+		if d := math.Remainder(Node(c.JD, true), Pi2); math.Abs(d) > 1e-7 {
+			t.Errorf("True node at %.4f should be at 0. Got: %g", c.JD, d)
+		}
+	}
+}
+
+func TestNodeCrossingsNearStation(t *testing.T) {
+	// the true node turns direct; a longitude just past the turning point is
+	// passed several times within a few days
+	const start = 2451545.0
+	jd := start
+	for _, v := NodePositionWithVelocity(jd, true); v < 0; _, v = NodePositionWithVelocity(jd, true) {
+		jd += 0.1
+	}
+	target := Node(jd, true) + 1e-4
+	from, to := jd-20, jd+20
+
+	// brute force: changes of sign of the difference
+	exp := 0
+	prev := math.Remainder(Node(from, true)-target, Pi2)
+	for x := from + 0.01; x <= to; x += 0.01 {
+		d := math.Remainder(Node(x, true)-target, Pi2)
+		if d*prev < 0 {
+			exp++
+		}
+		prev = d
+	}
+	got, err := NodeCrossings(true, from, to, target)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if exp < 2 || len(got) != exp {
+		t.Errorf("Expected %d crossings. Got: %v", exp, got)
+	}
+}
@@ -3,7 +3,6 @@ package ephem
 import (
 	"fmt"
 
-	"github.com/ilbagatto/vsop87-go/earth"
 	"github.com/ilbagatto/vsop87-go/internal/heliocentric"
 	"github.com/ilbagatto/vsop87-go/internal/moon"
 	"github.com/ilbagatto/vsop87-go/mathutils"
@@ -15,19 +14,37 @@ const Pi2 = mathutils.Pi2
 // It’s exactly the same as internal/heliocentric.EclCoord.
 type EclCoord = heliocentric.EclCoord
 
-// Registry connects Body → related Computer.
-var Registry = map[Body]Computer{
-	Moon:    moonWrapper{},
-	Sun:     sunWrapper{},
-	Mercury: vsopPlanet{heliocentric.Mercury{}},
-	Venus:   vsopPlanet{heliocentric.Venus{}},
-	Mars:    vsopPlanet{heliocentric.Mars{}},
-	Jupiter: vsopPlanet{heliocentric.Jupiter{}},
-	Saturn:  vsopPlanet{heliocentric.Saturn{}},
-	Uranus:  vsopPlanet{heliocentric.Uranus{}},
-	Neptune: vsopPlanet{heliocentric.Neptune{}},
-	Pluto:   plutoWrapper{},
-}
+// Reduction selects the corrections applied to positions: see Apparent,
+// Astrometric and Geometric.
+type Reduction = heliocentric.Reduction
+
+const (
+	// Apparent positions include light-time, aberration and nutation; they
+	// are referred to the ecliptic and true equinox of date. This is the default.
+	Apparent = heliocentric.Apparent
+	// Astrometric positions include light-time only; they are referred to the
+	// mean ecliptic and equinox of J2000.
+	Astrometric = heliocentric.Astrometric
+	// Geometric positions are instantaneous; they are referred to the mean
+	// ecliptic and equinox of date.
+	Geometric = heliocentric.Geometric
+)
+
+// Frame selects the reference frame of positions; see WithFrame.
+type Frame = heliocentric.Frame
+
+const (
+	// FrameDefault is the natural frame of the reduction: J2000 for
+	// astrometric positions, of date otherwise.
+	FrameDefault = heliocentric.FrameDefault
+	// FrameOfDate is the ecliptic and equator of date, true for apparent
+	// positions and mean otherwise.
+	FrameOfDate = heliocentric.FrameOfDate
+	// FrameJ2000 is the mean ecliptic and equator of J2000.
+	FrameJ2000 = heliocentric.FrameJ2000
+	// FrameICRS is J2000 corrected for the frame bias.
+	FrameICRS = heliocentric.FrameICRS
+)
 
 // Node returns the Moon’s mean (trueNode=false) or true (trueNode=true)
 // ascending node longitude (radians) for the given Julian Day.
@@ -35,61 +52,101 @@ var Registry = map[Body]Computer{
 // It’s just an alias of internal/moon.Node.
 var Node = moon.Node
 
-// EclipticPosition returns the apparent ecliptic geocentric coordinates (lambda, beta, distance) of any body.
-func EclipticPosition(body Body, jd, deltaPsi float64) (EclCoord, error) {
-	comp, ok := Registry[body]
-	if !ok {
-		return EclCoord{}, fmt.Errorf("ephem: unsupported body %v", body)
-	}
-	return comp.Compute(jd, deltaPsi), nil
+// UnsupportedBodyError is returned when a body has no backend, or when an
+// operation makes no sense for it, e.g. stations of the Sun.
+type UnsupportedBodyError struct {
+	Body Body
+	Op   string // operation, empty for positions
 }
 
-// EclipticPositionWithVelocity returns geocentric ecliptic coordinates (of date)
-// and signed daily longitudinal speed (radians/day) at the given JD(TT).
-// It uses a small central-difference step and angle-safe normalization.
-// EclipticPositionWithVelocity returns geocentric ecliptic coordinates (of date)
-// and signed daily longitudinal speed (radians/day) at the given JD(TT).
-// It uses analytic speed where supported (currently: Moon), otherwise numeric.
-func EclipticPositionWithVelocity(body Body, jdTT float64) (EclCoord, float64, error) {
+func (e *UnsupportedBodyError) Error() string {
+	if e.Op == "" {
+		return fmt.Sprintf("ephem: unsupported body %v", e.Body)
+	}
+	return fmt.Sprintf("ephem: %s: unsupported body %v", e.Op, e.Body)
+}
 
-	h := stepFor(body)
+// unsupportedBody returns the error reported for bodies without a backend.
+func unsupportedBody(body Body) error {
+	return &UnsupportedBodyError{Body: body}
+}
 
-	// local helper: compute position at given JD(TT) with proper nutation
-	getPos := func(jd float64) (EclCoord, error) {
-		deltaPsi, _ := earth.Nutation(jd)
-		p, err := EclipticPosition(body, jd, deltaPsi)
-		if err != nil {
-			return EclCoord{}, err
-		}
-		return p, nil
-	}
+// EclipticPosition returns the apparent ecliptic geocentric coordinates (lambda, beta, distance) of any body.
+func EclipticPosition(body Body, jd, deltaPsi float64) (EclCoord, error) {
+	return defaultEphemeris.EclipticPosition(body, jd, deltaPsi)
+}
 
-	// base and ±h positions
-	p0, err := getPos(jdTT)
+// EclipticPosition returns the ecliptic geocentric coordinates (lambda, beta, distance) of any body,
+// reduced and referred to the frame configured by WithReduction and WithFrame.
+// deltaPsi is used only by apparent positions of date.
+func (e *Ephemeris) EclipticPosition(body Body, jd, deltaPsi float64) (EclCoord, error) {
+	comp, err := e.computer(body)
 	if err != nil {
-		return EclCoord{}, 0, err
+		return EclCoord{}, err
 	}
-	// Analytic branch where supported
-	switch body {
-	case Moon:
-		vDeg := moon.AngularSpeed(jdTT, false)
-		v := mathutils.Radians(vDeg)
-		return p0, v, nil
+	if r, ok := comp.(reducer); ok {
+		return r.reduce(e.NewInstant(jd), e.reduction, e.frame, deltaPsi), nil
 	}
+	return comp.Compute(jd, deltaPsi), nil
+}
+
+// EclipticPositionAt is like EclipticPosition but takes nutation and, for
+// the Sun and the planets, Earth's heliocentric position from the instant.
+func EclipticPositionAt(body Body, in *Instant) (EclCoord, error) {
+	return defaultEphemeris.EclipticPositionAt(body, in)
+}
+
+// EclipticPositionAt is like EclipticPosition but takes nutation and, for
+// the Sun and the planets, Earth's heliocentric position from the instant.
+func (e *Ephemeris) EclipticPositionAt(body Body, in *Instant) (EclCoord, error) {
+	return e.positionAt(body, in, e.reduction, e.frame)
+}
 
-	pp, err := getPos(jdTT + h)
+// positionAt returns the ecliptic position of body at the instant, reduced
+// as red and referred to frame. Custom backends always give apparent
+// positions of date.
+func (e *Ephemeris) positionAt(body Body, in *Instant, red Reduction, frame Frame) (EclCoord, error) {
+	comp, err := e.computer(body)
 	if err != nil {
-		return EclCoord{}, 0, err
+		return EclCoord{}, err
 	}
-	pm, err := getPos(jdTT - h)
-	if err != nil {
-		return EclCoord{}, 0, err
+	r, ok := comp.(reducer)
+	if !ok {
+		deltaPsi, _ := in.Nutation()
+		return comp.Compute(in.JD(), deltaPsi), nil
+	}
+	var deltaPsi float64
+	if red == Apparent && frame.Resolve(red) == FrameOfDate {
+		deltaPsi, _ = in.Nutation()
 	}
+	return r.reduce(in, red, frame, deltaPsi), nil
+}
+
+// EclipticPositionWithVelocity returns geocentric ecliptic coordinates (of date)
+// and signed daily longitudinal speed (radians/day) at the given JD(TT).
+// It uses analytic speed where supported (currently: Moon), otherwise numeric.
+func EclipticPositionWithVelocity(body Body, jdTT float64) (EclCoord, float64, error) {
+	return defaultEphemeris.EclipticPositionWithVelocity(body, jdTT)
+}
 
-	// central difference (radians/day), angle-safe
-	v := centralDiffRad(ppLambda(pp), ppLambda(pm), h)
+// EclipticPositionWithVelocity returns geocentric ecliptic coordinates (of date)
+// and signed daily longitudinal speed (radians/day) at the given JD(TT).
+func (e *Ephemeris) EclipticPositionWithVelocity(body Body, jdTT float64) (EclCoord, float64, error) {
+	return e.EclipticPositionWithVelocityAt(body, e.NewInstant(jdTT))
+}
 
-	return p0, v, nil
+// EclipticPositionWithVelocityAt is like EclipticPositionWithVelocity but
+// uses the instant and its memoized offsets, so that bodies computed for
+// the same instant share nutation and Earth's position.
+func EclipticPositionWithVelocityAt(body Body, in *Instant) (EclCoord, float64, error) {
+	return defaultEphemeris.EclipticPositionWithVelocityAt(body, in)
+}
+
+// EclipticPositionWithVelocityAt is like EclipticPositionWithVelocity but
+// uses the instant and its memoized offsets.
+func (e *Ephemeris) EclipticPositionWithVelocityAt(body Body, in *Instant) (EclCoord, float64, error) {
+	pos, vel, err := e.EclipticStateAt(body, in)
+	return pos, vel.Lambda, err
 }
 
 // NodePositionWithVelocity returns the Moon’s ascending node longitude (radians)
@@ -108,9 +165,6 @@ func NodePositionWithVelocity(jdTT float64, trueNode bool) (float64, float64) {
 	return n0, v
 }
 
-// ppLambda extracts longitude in degrees (rename if your field is different).
-func ppLambda(p EclCoord) float64 { return p.Lambda }
-
 // stepFor picks a numerical step (in days) per body for a stable derivative.
 func stepFor(body Body) float64 {
 	switch body {
@@ -0,0 +1,159 @@
+package ephem
+
+import (
+	"github.com/ilbagatto/vsop87-go/internal/heliocentric"
+)
+
+// NutationModel returns nutation in longitude Δψ and in obliquity Δε
+// (radians) for the given JD(TT). earth.Nutation is the default model.
+type NutationModel func(jdTT float64) (deltaPsi, deltaEps float64)
+
+// Precision selects how many terms of the VSOP87 series are summed for the
+// Sun and the planets. Lower precision is faster.
+type Precision int
+
+const (
+	// PrecisionFull uses the complete VSOP87 series.
+	PrecisionFull Precision = iota
+	// PrecisionHigh drops terms with amplitude below 1e-8 (≈0.002″).
+	PrecisionHigh
+	// PrecisionMedium drops terms with amplitude below 1e-7 (≈0.02″).
+	PrecisionMedium
+	// PrecisionLow drops terms with amplitude below 1e-6 (≈0.2″ per term).
+	PrecisionLow
+)
+
+// minAmplitude returns the smallest VSOP87 amplitude kept at precision p.
+func (p Precision) minAmplitude() float64 {
+	switch p {
+	case PrecisionHigh:
+		return 1e-8
+	case PrecisionMedium:
+		return 1e-7
+	case PrecisionLow:
+		return 1e-6
+	default:
+		return 0
+	}
+}
+
+// Ephemeris is a configured set of models used to compute positions.
+// It is immutable once built, so it is safe for concurrent use, and
+// several differently configured instances may coexist in one process.
+//
+// The package-level functions use a default instance built by New(). Until
+// the deprecated Registry is removed, that instance is the exception: its
+// backends can be changed through Registry, and it is safe for concurrent
+// use only while nobody does.
+type Ephemeris struct {
+	computers map[Body]Computer
+	earth     heliocentric.Heliocentric
+	nutation  NutationModel
+	precision Precision
+	reduction Reduction
+	frame     Frame
+}
+
+// Option configures an Ephemeris.
+type Option func(*Ephemeris)
+
+// WithBackend replaces the computer used for body.
+func WithBackend(body Body, c Computer) Option {
+	return func(e *Ephemeris) {
+		e.computers[body] = c
+	}
+}
+
+// WithNutation replaces the nutation model; nil restores earth.Nutation.
+func WithNutation(model NutationModel) Option {
+	return func(e *Ephemeris) {
+		e.nutation = model
+	}
+}
+
+// WithReduction selects the corrections applied by the built-in backends;
+// Apparent is the default. Topocentric and horizontal positions and rise,
+// transit and set times are always apparent.
+func WithReduction(r Reduction) Option {
+	return func(e *Ephemeris) {
+		e.reduction = r
+	}
+}
+
+// WithFrame selects the reference frame of ecliptic and equatorial
+// positions computed by the built-in backends; FrameDefault is the natural
+// frame of the reduction. Like WithReduction, it does not apply to
+// topocentric and horizontal positions.
+func WithFrame(f Frame) Option {
+	return func(e *Ephemeris) {
+		e.frame = f
+	}
+}
+
+// WithPrecision selects the truncation level of the VSOP87 series used by
+// the built-in backends of the Sun and the planets.
+func WithPrecision(p Precision) Option {
+	return func(e *Ephemeris) {
+		e.precision = p
+	}
+}
+
+// defaultEphemeris backs the package-level functions.
+var defaultEphemeris = New()
+
+// Default returns the instance used by the package-level functions. It is
+// mutable through Registry; see Ephemeris.
+func Default() *Ephemeris {
+	return defaultEphemeris
+}
+
+// Registry connects Body → related Computer. It is the table of the default
+// instance, so changes to it affect the package-level functions.
+//
+// Deprecated: build an instance with New and WithBackend instead. Modifying
+// Registry while positions are computed is not safe. It will be removed in
+// the next release.
+var Registry = defaultEphemeris.computers
+
+// New builds an Ephemeris with the built-in backends for all bodies,
+// modified by opts.
+func New(opts ...Option) *Ephemeris {
+	e := &Ephemeris{
+		computers: map[Body]Computer{
+			Moon:    moonWrapper{},
+			Sun:     sunWrapper{},
+			Mercury: vsopPlanet{heliocentric.Mercury{}},
+			Venus:   vsopPlanet{heliocentric.Venus{}},
+			Mars:    vsopPlanet{heliocentric.Mars{}},
+			Jupiter: vsopPlanet{heliocentric.Jupiter{}},
+			Saturn:  vsopPlanet{heliocentric.Saturn{}},
+			Uranus:  vsopPlanet{heliocentric.Uranus{}},
+			Neptune: vsopPlanet{heliocentric.Neptune{}},
+			Pluto:   plutoWrapper{},
+		},
+		earth: heliocentric.Earth{},
+	}
+	for _, opt := range opts {
+		opt(e)
+	}
+
+	if minAmp := e.precision.minAmplitude(); minAmp > 0 {
+		e.earth = heliocentric.NewTruncated(e.earth, minAmp)
+		for body, c := range e.computers {
+			// keep user-supplied backends intact
+			if p, ok := c.(vsopPlanet); ok {
+				e.computers[body] = vsopPlanet{heliocentric.NewTruncated(p.hc, minAmp)}
+			}
+		}
+	}
+	return e
+}
+
+// computer returns the backend for body.
+func (e *Ephemeris) computer(body Body) (Computer, error) {
+	comp, ok := e.computers[body]
+	if !ok {
+		return nil, unsupportedBody(body)
+	}
+	return comp, nil
+}
@@ -0,0 +1,242 @@
+package ephem
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/ilbagatto/vsop87-go/coco"
+	"github.com/ilbagatto/vsop87-go/earth"
+	"github.com/ilbagatto/vsop87-go/mathutils"
+)
+
+// fixedComputer always returns the same position.
+type fixedComputer EclCoord
+
+func (c fixedComputer) Compute(jd, deltaPsi float64) EclCoord {
+	return EclCoord(c)
+}
+
+func TestEphemerisDefault(t *testing.T) {
+	const jd = 2451545.0
+	e := New()
+	for _, b := range []Body{Sun, Moon, Mars, Pluto} {
+		exp, err := EquatorialPosition(b, jd)
+		if err != nil {
+			t.Fatalf("EquatorialPosition(%v): %v", b, err)
+		}
+		got, err := e.EquatorialPosition(b, jd)
+		if err != nil {
+			t.Fatalf("Ephemeris.EquatorialPosition(%v): %v", b, err)
+		}
+		if got != exp {
+			t.Errorf("%v: %+v != %+v", b, got, exp)
+		}
+	}
+}
+
+func TestEphemerisWithBackend(t *testing.T) {
+	exp := EclCoord{Lambda: 1, Beta: 0.1, Radius: 2}
+	e := New(WithBackend(Mars, fixedComputer(exp)))
+
+	got, err := e.EclipticPosition(Mars, 2451545.0, 0)
+	if err != nil {
+		t.Fatalf("EclipticPosition: %v", err)
+	}
+	if got != exp {
+		t.Errorf("Mars should be %+v. Got: %+v", exp, got)
+	}
+	// the default instance is not affected
+	def, _ := EclipticPosition(Mars, 2451545.0, 0)
+	if def == exp {
+		t.Errorf("default instance uses the custom backend")
+	}
+}
+
+func TestRegistry(t *testing.T) {
+	if _, ok := Registry[Mars].(vsopPlanet); !ok {
+		t.Fatalf("Registry should hold the built-in backend of Mars. Got: %T", Registry[Mars])
+	}
+	exp := EclCoord{Lambda: 1, Beta: 0.1, Radius: 2}
+	saved := Registry[Mars]
+	Registry[Mars] = fixedComputer(exp)
+	defer func() { Registry[Mars] = saved }()
+
+	got, err := EclipticPosition(Mars, 2451545.0, 0)
+	if err != nil {
+		t.Fatalf("EclipticPosition: %v", err)
+	}
+	if got != exp {
+		t.Errorf("Mars should be %+v. Got: %+v", exp, got)
+	}
+}
+
+func TestEphemerisWithNutation(t *testing.T) {
+	const jd = 2451545.0
+	e := New(WithNutation(func(float64) (float64, float64) { return 0, 0 }))
+
+	mean, err := e.EclipticPositionAt(Sun, e.NewInstant(jd))
+	if err != nil {
+		t.Fatalf("EclipticPositionAt: %v", err)
+	}
+	apparent, err := EclipticPositionAt(Sun, NewInstant(jd))
+	if err != nil {
+		t.Fatalf("EclipticPositionAt: %v", err)
+	}
+	dpsi, _ := earth.Nutation(jd)
+	got := mathutils.AngNormPi(apparent.Lambda - mean.Lambda)
+	if !mathutils.AlmostEqual(got, dpsi, 1e-12) {
+		t.Errorf("Longitude difference should be Δψ = %g. Got: %g", dpsi, got)
+	}
+}
+
+func TestEphemerisWithPrecision(t *testing.T) {
+	const jd = 2460000.5
+	threshold := mathutils.Radians(2.0 / 3600)
+	low := New(WithPrecision(PrecisionLow))
+	for _, b := range []Body{Sun, Mercury, Venus, Mars, Jupiter, Saturn, Uranus, Neptune} {
+		exp, _ := EclipticPosition(b, jd, 0)
+		got, err := low.EclipticPosition(b, jd, 0)
+		if err != nil {
+			t.Fatalf("EclipticPosition(%v): %v", b, err)
+		}
+		if d := mathutils.AngNormPi(got.Lambda - exp.Lambda); !mathutils.AlmostEqual(d, 0, threshold) {
+			t.Errorf("%v: longitude differs by %.2f″", b, mathutils.Degrees(d)*3600)
+		}
+		if !mathutils.AlmostEqual(got.Beta, exp.Beta, threshold) {
+			t.Errorf("%v: latitude differs by %.2f″", b, mathutils.Degrees(got.Beta-exp.Beta)*3600)
+		}
+	}
+}
+
+func TestEphemerisConcurrent(t *testing.T) {
+	instances := []*Ephemeris{New(), New(WithPrecision(PrecisionMedium))}
+	var wg sync.WaitGroup
+	for _, e := range instances {
+		for i := range 4 {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				jd := 2451545.0 + float64(i)
+				if _, err := e.EquatorialPosition(Jupiter, jd); err != nil {
+					t.Errorf("EquatorialPosition: %v", err)
+				}
+			}()
+		}
+	}
+	wg.Wait()
+}
+
+func TestEphemerisWithReductionAstrometric(t *testing.T) {
+	// Meeus, example 37.a: astrometric position of Pluto referred to J2000
+	const jd = 2448908.5
+	const threshold = 1e-4
+	e := New(WithReduction(Astrometric))
+
+	got, err := e.EquatorialPosition(Pluto, jd)
+	if err != nil {
+		t.Fatalf("EquatorialPosition: %v", err)
+	}
+	if a := mathutils.Degrees(got.Alpha); !mathutils.AlmostEqual(a, 232.93231, threshold) {
+		t.Errorf("Alpha should be %.5f. Got: %.5f", 232.93231, a)
+	}
+	if d := mathutils.Degrees(got.Delta); !mathutils.AlmostEqual(d, -4.45802, threshold) {
+		t.Errorf("Delta should be %.5f. Got: %.5f", -4.45802, d)
+	}
+}
+
+func TestEphemerisWithReductionGeometric(t *testing.T) {
+	// the apparent Sun differs from the geometric one by aberration and nutation
+	const jd = 2448908.5
+	e := New(WithReduction(Geometric))
+	in := NewInstant(jd)
+
+	geo, err := e.EclipticPositionAt(Sun, in)
+	if err != nil {
+		t.Fatalf("EclipticPositionAt: %v", err)
+	}
+	app, err := EclipticPositionAt(Sun, in)
+	if err != nil {
+		t.Fatalf("EclipticPositionAt: %v", err)
+	}
+	dpsi, _ := in.Nutation()
+	exp := dpsi - mathutils.Radians(20.4898/3600)/geo.Radius
+	if got := mathutils.AngNormPi(app.Lambda - geo.Lambda); !mathutils.AlmostEqual(got, exp, 1e-12) {
+		t.Errorf("Difference should be %g. Got: %g", exp, got)
+	}
+}
+
+func TestEphemerisWithReductionTopocentric(t *testing.T) {
+	// topocentric positions are always apparent
+	const jd = 2451545.0
+	obs := Observer{Lat: mathutils.Radians(51.5)}
+	exp, err := TopocentricEquatorialPosition(Moon, jd, obs)
+	if err != nil {
+		t.Fatalf("TopocentricEquatorialPosition: %v", err)
+	}
+	got, err := New(WithReduction(Astrometric)).TopocentricEquatorialPosition(Moon, jd, obs)
+	if err != nil {
+		t.Fatalf("TopocentricEquatorialPosition: %v", err)
+	}
+	if got != exp {
+		t.Errorf("%+v != %+v", got, exp)
+	}
+}
+
+func TestEphemerisWithFrameJ2000(t *testing.T) {
+	// Meeus, example 26.a: geometric rectangular coordinates of the Sun,
+	// referred to the equator and equinox of J2000
+	const jd = 2448908.5
+	const threshold = 1e-5
+	e := New(WithReduction(Geometric), WithFrame(FrameJ2000))
+
+	equ, err := e.EquatorialPosition(Sun, jd)
+	if err != nil {
+		t.Fatalf("EquatorialPosition: %v", err)
+	}
+	got := mathutils.Spherical{R: equ.Radius, Theta: equ.Delta, Phi: equ.Alpha}.ToRectangular()
+	exp := mathutils.Point3D{X: -0.9373959, Y: -0.3131679, Z: -0.1357792}
+	if !mathutils.AlmostEqual(got.X, exp.X, threshold) ||
+		!mathutils.AlmostEqual(got.Y, exp.Y, threshold) ||
+		!mathutils.AlmostEqual(got.Z, exp.Z, threshold) {
+		t.Errorf("Sun should be %+v. Got: %+v", exp, got)
+	}
+}
+
+func TestEphemerisWithFrameOfDate(t *testing.T) {
+	// astrometric positions of date precessed to J2000 match the default frame
+	const jd = 2460000.5
+	ofDate := New(WithReduction(Astrometric), WithFrame(FrameOfDate))
+	j2000 := New(WithReduction(Astrometric))
+
+	for _, b := range []Body{Moon, Mars, Pluto} {
+		pos, err := ofDate.EclipticPosition(b, jd, 0)
+		if err != nil {
+			t.Fatalf("EclipticPosition(%v): %v", b, err)
+		}
+		exp, err := j2000.EclipticPosition(b, jd, 0)
+		if err != nil {
+			t.Fatalf("EclipticPosition(%v): %v", b, err)
+		}
+		lam, bet := coco.MeanToAstrometric2000(pos.Lambda, pos.Beta, jd)
+		if !mathutils.AlmostEqual(lam, exp.Lambda, 1e-9) || !mathutils.AlmostEqual(bet, exp.Beta, 1e-9) {
+			t.Errorf("%v: (%.9f, %.9f) != (%.9f, %.9f)", b, lam, bet, exp.Lambda, exp.Beta)
+		}
+	}
+}
+
+func TestEphemerisWithFrameICRS(t *testing.T) {
+	// the frame bias is a few tens of milliarcseconds
+	const jd = 2460000.5
+	j2000, err := New(WithFrame(FrameJ2000)).EquatorialPosition(Mars, jd)
+	if err != nil {
+		t.Fatalf("EquatorialPosition: %v", err)
+	}
+	icrs, err := New(WithFrame(FrameICRS)).EquatorialPosition(Mars, jd)
+	if err != nil {
+		t.Fatalf("EquatorialPosition: %v", err)
+	}
+	ra, dec := coco.J2000ToICRS(j2000.Alpha, j2000.Delta)
+	if !mathutils.AlmostEqual(icrs.Alpha, ra, 1e-12) || !mathutils.AlmostEqual(icrs.Delta, dec, 1e-12) {
+		t.Errorf("ICRS should be (%.12f, %.12f). Got: (%.12f, %.12f)", ra, dec, icrs.Alpha, icrs.Delta)
+	}
+}
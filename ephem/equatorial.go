@@ -0,0 +1,94 @@
+package ephem
+
+import (
+	"github.com/ilbagatto/vsop87-go/coco"
+	"github.com/ilbagatto/vsop87-go/earth"
+)
+
+// EquCoord holds geocentric or topocentric equatorial coordinates.
+type EquCoord struct {
+	Alpha  float64 // right ascension (radians)
+	Delta  float64 // declination (radians)
+	Radius float64 // distance (AU)
+}
+
+// EquatorialPosition returns the apparent geocentric equatorial coordinates
+// (right ascension, declination, distance) of any body at the given JD(TT).
+// Nutation is computed internally and the true obliquity of date is used.
+func EquatorialPosition(body Body, jdTT float64) (EquCoord, error) {
+	return defaultEphemeris.EquatorialPosition(body, jdTT)
+}
+
+// EquatorialPosition returns the geocentric equatorial coordinates (right
+// ascension, declination, distance) of any body at the given JD(TT), reduced
+// and referred to the frame configured by WithReduction and WithFrame.
+func (e *Ephemeris) EquatorialPosition(body Body, jdTT float64) (EquCoord, error) {
+	return e.EquatorialPositionAt(body, e.NewInstant(jdTT))
+}
+
+// EquatorialPositionAt is like EquatorialPosition but takes nutation,
+// obliquity and Earth's position from the instant.
+func EquatorialPositionAt(body Body, in *Instant) (EquCoord, error) {
+	return defaultEphemeris.EquatorialPositionAt(body, in)
+}
+
+// EquatorialPositionAt is like EquatorialPosition but takes nutation,
+// obliquity and Earth's position from the instant.
+func (e *Ephemeris) EquatorialPositionAt(body Body, in *Instant) (EquCoord, error) {
+	return e.equatorialAt(body, in, e.reduction, e.frame)
+}
+
+// equatorialAt returns the equatorial position of body at the instant,
+// reduced as red and referred to frame.
+func (e *Ephemeris) equatorialAt(body Body, in *Instant, red Reduction, frame Frame) (EquCoord, error) {
+	ecl, err := e.positionAt(body, in, red, frame)
+	if err != nil {
+		return EquCoord{}, err
+	}
+	return eclToEqu(ecl, obliquity(in, red, frame)), nil
+}
+
+// obliquity returns the obliquity of the ecliptic of frame for reduction red.
+func obliquity(in *Instant, red Reduction, frame Frame) float64 {
+	switch {
+	case frame.Resolve(red) != FrameOfDate:
+		return earth.ObliquityJ2000
+	case red == Apparent:
+		return in.TrueObliquity()
+	default:
+		return in.MeanObliquity()
+	}
+}
+
+// EquatorialPositionWithVelocity returns apparent geocentric equatorial
+// coordinates of date and signed daily speed in right ascension
+// (radians/day) at the given JD(TT). See EquatorialState for all rates.
+func EquatorialPositionWithVelocity(body Body, jdTT float64) (EquCoord, float64, error) {
+	return defaultEphemeris.EquatorialPositionWithVelocity(body, jdTT)
+}
+
+// EquatorialPositionWithVelocity returns geocentric equatorial coordinates
+// and signed daily speed in right ascension (radians/day) at the given JD(TT).
+func (e *Ephemeris) EquatorialPositionWithVelocity(body Body, jdTT float64) (EquCoord, float64, error) {
+	return e.EquatorialPositionWithVelocityAt(body, e.NewInstant(jdTT))
+}
+
+// EquatorialPositionWithVelocityAt is like EquatorialPositionWithVelocity
+// but uses the instant and its memoized offsets.
+func EquatorialPositionWithVelocityAt(body Body, in *Instant) (EquCoord, float64, error) {
+	return defaultEphemeris.EquatorialPositionWithVelocityAt(body, in)
+}
+
+// EquatorialPositionWithVelocityAt is like EquatorialPositionWithVelocity
+// but uses the instant and its memoized offsets.
+func (e *Ephemeris) EquatorialPositionWithVelocityAt(body Body, in *Instant) (EquCoord, float64, error) {
+	pos, vel, err := e.EquatorialStateAt(body, in)
+	return pos, vel.Alpha, err
+}
+
+// eclToEqu converts ecliptic coordinates to equatorial ones using
+// obliquity eps (radians). The distance is kept as is.
+func eclToEqu(ecl EclCoord, eps float64) EquCoord {
+	ra, dec := coco.Ecl2Equ(ecl.Lambda, ecl.Beta, eps)
+	return EquCoord{Alpha: ra, Delta: dec, Radius: ecl.Radius}
+}
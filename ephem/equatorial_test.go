@@ -0,0 +1,45 @@
+package ephem
+
+import (
+	"testing"
+
+	"github.com/ilbagatto/vsop87-go/mathutils"
+)
+
+func TestEquatorialPositionSun(t *testing.T) {
+	// Meeus, p.169: 1992 October 13.0 TD
+	const (
+		jd        = 2448908.5
+		threshold = 1e-3
+	)
+	got, err := EquatorialPosition(Sun, jd)
+	if err != nil {
+		t.Fatalf("EquatorialPosition: %v", err)
+	}
+	expA := 198.378121
+	expD := -7.783817
+	gotA := mathutils.Degrees(got.Alpha)
+	gotD := mathutils.Degrees(got.Delta)
+	if !mathutils.AlmostEqual(gotA, expA, threshold) {
+		t.Errorf("Alpha should be %.6f. Got: %.6f", expA, gotA)
+	}
+	if !mathutils.AlmostEqual(gotD, expD, threshold) {
+		t.Errorf("Delta should be %.6f. Got: %.6f", expD, gotD)
+	}
+}
+
+func TestEquatorialPositionWithVelocity(t *testing.T) {
+	const jd = 2448908.5
+	pos, vel, err := EquatorialPositionWithVelocity(Sun, jd)
+	if err != nil {
+		t.Fatalf("EquatorialPositionWithVelocity: %v", err)
+	}
+	next, err := EquatorialPosition(Sun, jd+1)
+	if err != nil {
+		t.Fatalf("EquatorialPosition: %v", err)
+	}
+	exp := mathutils.AngNormPi(next.Alpha - pos.Alpha)
+	if !mathutils.AlmostEqual(vel, exp, 1e-4) {
+		t.Errorf("RA speed should be about %.6f. Got: %.6f", exp, vel)
+	}
+}
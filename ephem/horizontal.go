@@ -0,0 +1,68 @@
+package ephem
+
+import (
+	"math"
+
+	"github.com/ilbagatto/vsop87-go/coco"
+	"github.com/ilbagatto/vsop87-go/earth"
+	"github.com/ilbagatto/vsop87-go/mathutils"
+)
+
+// AzimuthOrigin selects the zero point and direction of azimuth.
+type AzimuthOrigin int
+
+const (
+	// AzimuthNorth measures azimuth eastwards from North (navigation).
+	AzimuthNorth AzimuthOrigin = iota
+	// AzimuthSouth measures azimuth westwards from South (Meeus, coco.Equ2Hor).
+	AzimuthSouth
+)
+
+// HorCoord holds topocentric horizontal coordinates.
+type HorCoord struct {
+	Azimuth   float64 // azimuth, radians, per the chosen AzimuthOrigin
+	Altitude  float64 // true (airless) altitude, radians
+	Refracted float64 // apparent altitude, corrected for refraction, radians
+}
+
+// HorizontalOptions controls the result of HorizontalPosition.
+type HorizontalOptions struct {
+	// Azimuth selects the azimuth convention; North-based by default.
+	Azimuth AzimuthOrigin
+	// Atmosphere defines the refraction conditions; nil means
+	// earth.StandardAtmosphere. Pass &earth.Atmosphere{} to disable refraction.
+	Atmosphere *earth.Atmosphere
+}
+
+// HorizontalPosition returns azimuth and altitude of any body, as seen by the
+// observer at the given JD(UT). Diurnal parallax is applied, and the
+// refracted altitude is obtained with Saemundsson's formula.
+func HorizontalPosition(body Body, jdUT float64, obs Observer, opts HorizontalOptions) (HorCoord, error) {
+	return defaultEphemeris.HorizontalPosition(body, jdUT, obs, opts)
+}
+
+// HorizontalPosition returns azimuth and altitude of any body, as seen by the
+// observer at the given JD(UT).
+func (e *Ephemeris) HorizontalPosition(body Body, jdUT float64, obs Observer, opts HorizontalOptions) (HorCoord, error) {
+	in := e.NewInstantUT(jdUT)
+	equ, err := e.TopocentricEquatorialPositionAt(body, in, obs)
+	if err != nil {
+		return HorCoord{}, err
+	}
+	lst := in.SiderealTime() + obs.Lng
+
+	azm, alt := coco.Equ2Hor(equ.Alpha, equ.Delta, lst-equ.Alpha, obs.Lat)
+	if opts.Azimuth == AzimuthNorth {
+		azm = mathutils.ReduceRad(azm + math.Pi)
+	}
+
+	atm := earth.StandardAtmosphere
+	if opts.Atmosphere != nil {
+		atm = *opts.Atmosphere
+	}
+	return HorCoord{
+		Azimuth:   azm,
+		Altitude:  alt,
+		Refracted: alt + earth.Saemundsson(alt, atm),
+	}, nil
+}
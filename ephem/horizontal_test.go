@@ -0,0 +1,69 @@
+package ephem
+
+import (
+	"math"
+	"testing"
+
+	"github.com/ilbagatto/vsop87-go/earth"
+	"github.com/ilbagatto/vsop87-go/mathutils"
+	"github.com/ilbagatto/vsop87-go/timeutils"
+)
+
+func TestHorizontalPositionAtTransit(t *testing.T) {
+	// At meridian transit the Sun is due South (from a northern latitude)
+	// and its altitude is 90° − φ + δ.
+	const jd = 2460482.5 // 2024 June 21
+	obs := Observer{Lat: mathutils.Radians(51.5), Lng: mathutils.Radians(-0.1)}
+
+	rs, err := RiseTransitSet(Sun, jd, obs.Lat, obs.Lng)
+	if err != nil {
+		t.Fatalf("RiseTransitSet: %v", err)
+	}
+	got, err := HorizontalPosition(Sun, rs.Transit, obs, HorizontalOptions{})
+	if err != nil {
+		t.Fatalf("HorizontalPosition: %v", err)
+	}
+	if !mathutils.AlmostEqual(got.Azimuth, math.Pi, 1e-4) {
+		t.Errorf("Azimuth should be 180°. Got: %.4f°", mathutils.Degrees(got.Azimuth))
+	}
+	equ, _ := TopocentricEquatorialPosition(Sun, rs.Transit+timeutils.DeltaT(rs.Transit)*timeutils.DaysPerSec, obs)
+	exp := math.Pi/2 - obs.Lat + equ.Delta
+	if !mathutils.AlmostEqual(got.Altitude, exp, 1e-4) {
+		t.Errorf("Altitude should be %.4f°. Got: %.4f°", mathutils.Degrees(exp), mathutils.Degrees(got.Altitude))
+	}
+	if got.Refracted <= got.Altitude {
+		t.Errorf("Refracted altitude should exceed the true one")
+	}
+
+	south, err := HorizontalPosition(Sun, rs.Transit, obs, HorizontalOptions{
+		Azimuth:    AzimuthSouth,
+		Atmosphere: &earth.Atmosphere{},
+	})
+	if err != nil {
+		t.Fatalf("HorizontalPosition: %v", err)
+	}
+	if !mathutils.AlmostEqual(mathutils.AngNormPi(south.Azimuth), 0, 1e-4) {
+		t.Errorf("South-based azimuth should be 0°. Got: %.4f°", mathutils.Degrees(south.Azimuth))
+	}
+	if south.Refracted != south.Altitude {
+		t.Errorf("Refraction should be disabled")
+	}
+}
+
+func TestHorizontalPositionAtSunrise(t *testing.T) {
+	// At standard sunrise the upper limb touches the apparent horizon.
+	const jd = 2460482.5
+	obs := Observer{Lat: mathutils.Radians(51.5), Lng: mathutils.Radians(-0.1)}
+	rs, err := RiseTransitSet(Sun, jd, obs.Lat, obs.Lng)
+	if err != nil {
+		t.Fatalf("RiseTransitSet: %v", err)
+	}
+	got, err := HorizontalPosition(Sun, rs.Rise, obs, HorizontalOptions{})
+	if err != nil {
+		t.Fatalf("HorizontalPosition: %v", err)
+	}
+	// −16′ semidiameter within a few arcminutes
+	if !mathutils.AlmostEqual(mathutils.Degrees(got.Refracted), -0.2667, 0.05) {
+		t.Errorf("Refracted altitude should be about −0.27°. Got: %.4f°", mathutils.Degrees(got.Refracted))
+	}
+}
@@ -0,0 +1,84 @@
+package ephem
+
+import "github.com/ilbagatto/vsop87-go/internal/physical"
+
+// Illumination describes the phase and brightness of a planet as seen from
+// the Earth (Meeus, ch. 41).
+type Illumination struct {
+	PhaseAngle    float64 // Sun–planet–Earth angle i, radians
+	Illuminated   float64 // illuminated fraction of the disk, (1 + cos i) / 2
+	Elongation    float64 // Sun–Earth–planet angle, radians
+	Magnitude     float64 // apparent visual magnitude
+	HelioDistance float64 // distance from the Sun r, AU, when the light left the planet
+	Distance      float64 // distance from the Earth Δ, AU
+
+	// For Saturn only: the Saturnicentric latitude of the Earth referred to
+	// the plane of the rings B, and the difference ΔU between the
+	// Saturnicentric longitudes of the Sun and the Earth, radians.
+	RingTilt, RingDeltaU float64
+}
+
+// magnitudeCoeffs holds the magnitude formulae of the planets except Saturn.
+var magnitudeCoeffs = map[Body]physical.MagnitudeCoeffs{
+	Mercury: physical.Mercury,
+	Venus:   physical.Venus,
+	Mars:    physical.Mars,
+	Jupiter: physical.Jupiter,
+	Uranus:  physical.Uranus,
+	Neptune: physical.Neptune,
+	Pluto:   physical.Pluto,
+}
+
+// PlanetIllumination returns the phase angle, illuminated fraction,
+// elongation and visual magnitude of a planet at jdTT. Magnitudes follow the
+// Astronomical Almanac 1984 formulae; that of Saturn includes the rings. The
+// Sun, the Moon and custom backends yield an *UnsupportedBodyError.
+func PlanetIllumination(body Body, jdTT float64) (Illumination, error) {
+	return defaultEphemeris.PlanetIllumination(body, jdTT)
+}
+
+// PlanetIllumination returns the illumination of a planet at jdTT, using
+// the ephemeris.
+func (e *Ephemeris) PlanetIllumination(body Body, jdTT float64) (Illumination, error) {
+	return e.PlanetIlluminationAt(body, e.NewInstant(jdTT))
+}
+
+// PlanetIlluminationAt is like PlanetIllumination but takes Earth's position
+// from the instant.
+func PlanetIlluminationAt(body Body, in *Instant) (Illumination, error) {
+	return defaultEphemeris.PlanetIlluminationAt(body, in)
+}
+
+// PlanetIlluminationAt is like PlanetIllumination but takes Earth's position
+// from the instant.
+func (e *Ephemeris) PlanetIlluminationAt(body Body, in *Instant) (Illumination, error) {
+	comp, err := e.computer(body)
+	if err != nil {
+		return Illumination{}, err
+	}
+	lt, ok := comp.(lightTimer)
+	if !ok {
+		return Illumination{}, &UnsupportedBodyError{Body: body, Op: "illumination"}
+	}
+	helio, delta := lt.lightTimeLBR(in)
+	r, R := helio.R, in.EarthLBR().R
+	i := physical.PhaseAngle(r, delta, R)
+	res := Illumination{
+		PhaseAngle:    i,
+		Illuminated:   physical.Illuminated(i),
+		Elongation:    physical.Elongation(r, delta, R),
+		HelioDistance: r,
+		Distance:      delta,
+	}
+	if body != Saturn {
+		res.Magnitude = physical.Magnitude(magnitudeCoeffs[body], r, delta, i)
+		return res, nil
+	}
+	pos, err := e.EclipticPositionAt(body, in)
+	if err != nil {
+		return Illumination{}, err
+	}
+	res.RingTilt, res.RingDeltaU = physical.SaturnRing(in.JD(), pos.Lambda, pos.Beta, helio.Phi, helio.Theta, r)
+	res.Magnitude = physical.SaturnMagnitude(r, delta, res.RingTilt, res.RingDeltaU)
+	return res, nil
+}
@@ -0,0 +1,58 @@
+package ephem
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ilbagatto/vsop87-go/mathutils"
+)
+
+func TestPlanetIlluminationVenus(t *testing.T) {
+	// Meeus, example 41.a: Venus on 1992 December 20, i = 72.96°, k = 0.647
+	got, err := PlanetIllumination(Venus, 2448976.5)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !mathutils.AlmostEqual(got.HelioDistance, 0.724604, 1e-5) {
+		t.Errorf("r should be 0.724604. Got: %.6f", got.HelioDistance)
+	}
+	if !mathutils.AlmostEqual(got.Distance, 0.910947, 1e-5) {
+		t.Errorf("Δ should be 0.910947. Got: %.6f", got.Distance)
+	}
+	if !mathutils.AlmostEqual(mathutils.Degrees(got.PhaseAngle), 72.96, 0.01) {
+		t.Errorf("Phase angle should be 72.96°. Got: %.2f", mathutils.Degrees(got.PhaseAngle))
+	}
+	if !mathutils.AlmostEqual(got.Illuminated, 0.647, 1e-3) {
+		t.Errorf("Illuminated fraction should be 0.647. Got: %.3f", got.Illuminated)
+	}
+	if !mathutils.AlmostEqual(got.Magnitude, -4.2, 0.05) {
+		t.Errorf("Magnitude should be -4.2. Got: %.2f", got.Magnitude)
+	}
+}
+
+func TestPlanetIlluminationSaturn(t *testing.T) {
+	// Meeus, example 45.a: Saturn on 1992 December 16, B = 16.442°, ΔU = 4.198°
+	got, err := PlanetIllumination(Saturn, 2448972.5)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !mathutils.AlmostEqual(mathutils.Degrees(got.RingTilt), 16.442, 0.002) {
+		t.Errorf("B should be 16.442°. Got: %.3f", mathutils.Degrees(got.RingTilt))
+	}
+	if !mathutils.AlmostEqual(mathutils.Degrees(got.RingDeltaU), 4.198, 0.002) {
+		t.Errorf("ΔU should be 4.198°. Got: %.3f", mathutils.Degrees(got.RingDeltaU))
+	}
+	if !mathutils.AlmostEqual(got.Magnitude, 0.74, 0.01) {
+		t.Errorf("Magnitude should be 0.74. Got: %.2f", got.Magnitude)
+	}
+}
+
+func TestPlanetIlluminationUnsupported(t *testing.T) {
+	for _, body := range []Body{Sun, Moon} {
+		_, err := PlanetIllumination(body, 2448976.5)
+		var ue *UnsupportedBodyError
+		if !errors.As(err, &ue) || ue.Body != body {
+			t.Errorf("%v: expected UnsupportedBodyError. Got: %v", body, err)
+		}
+	}
+}
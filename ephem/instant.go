@@ -0,0 +1,166 @@
+package ephem
+
+import (
+	"sync"
+
+	"github.com/ilbagatto/vsop87-go/earth"
+	"github.com/ilbagatto/vsop87-go/internal/heliocentric"
+	"github.com/ilbagatto/vsop87-go/mathutils"
+	"github.com/ilbagatto/vsop87-go/timeutils"
+)
+
+// Instant is a computation context for one moment of time. It lazily
+// computes and memoizes the quantities shared by all bodies: Earth's
+// heliocentric position, nutation, obliquity, ΔT and sidereal time.
+//
+// Build it once per moment with NewInstant or NewInstantUT and pass it to
+// the *At functions. The instant uses Earth's and nutation models of the
+// Ephemeris which created it. An Instant is safe for concurrent use and
+// must not be copied.
+type Instant struct {
+	jd float64 // JD(TT)
+	ut float64 // JD(UT)
+
+	earthModel heliocentric.Heliocentric
+	nutation   NutationModel
+
+	earthOnce sync.Once
+	earthLBR  mathutils.Spherical
+
+	earthRateOnce sync.Once
+	earthRate     mathutils.Spherical
+
+	nutOnce            sync.Once
+	deltaPsi, deltaEps float64
+
+	oblOnce       sync.Once
+	meanObliquity float64
+
+	sidOnce  sync.Once
+	sidereal float64
+
+	mu      sync.Mutex
+	offsets map[float64]*Instant
+}
+
+// NewInstant returns a computation context for the given JD(TT).
+func NewInstant(jdTT float64) *Instant {
+	return defaultEphemeris.NewInstant(jdTT)
+}
+
+// NewInstantUT returns a computation context for the given JD(UT).
+func NewInstantUT(jdUT float64) *Instant {
+	return defaultEphemeris.NewInstantUT(jdUT)
+}
+
+// NewInstant returns a computation context for the given JD(TT) which uses
+// the models of e.
+func (e *Ephemeris) NewInstant(jdTT float64) *Instant {
+	return &Instant{
+		jd:         jdTT,
+		ut:         jdTT - timeutils.DeltaT(jdTT)*timeutils.DaysPerSec,
+		earthModel: e.earth,
+		nutation:   e.nutation,
+	}
+}
+
+// NewInstantUT returns a computation context for the given JD(UT) which uses
+// the models of e.
+func (e *Ephemeris) NewInstantUT(jdUT float64) *Instant {
+	return &Instant{
+		jd:         jdUT + timeutils.DeltaT(jdUT)*timeutils.DaysPerSec,
+		ut:         jdUT,
+		earthModel: e.earth,
+		nutation:   e.nutation,
+	}
+}
+
+// JD returns the Julian Day (TT) of the instant.
+func (in *Instant) JD() float64 { return in.jd }
+
+// UT returns the Julian Day (UT) of the instant.
+func (in *Instant) UT() float64 { return in.ut }
+
+// DeltaT returns ΔT = TT − UT, in seconds.
+func (in *Instant) DeltaT() float64 {
+	return (in.jd - in.ut) * timeutils.SecPerDay
+}
+
+// EarthLBR returns Earth's heliocentric ecliptic coordinates of date
+// (Phi = L, Theta = B in radians, R in AU).
+func (in *Instant) EarthLBR() mathutils.Spherical {
+	in.earthOnce.Do(func() {
+		in.earthLBR = heliocentric.LBR(in.jd, in.earthModel)
+	})
+	return in.earthLBR
+}
+
+// EarthRate returns the rates of change of Earth's heliocentric coordinates
+// (Phi = dL/dt, Theta = dB/dt in radians/day, R = dR/dt in AU/day).
+func (in *Instant) EarthRate() mathutils.Spherical {
+	in.earthRateOnce.Do(func() {
+		_, in.earthRate = heliocentric.LBRWithRate(in.jd, in.earthModel)
+	})
+	return in.earthRate
+}
+
+// Nutation returns nutation in longitude Δψ and in obliquity Δε (radians).
+func (in *Instant) Nutation() (deltaPsi, deltaEps float64) {
+	in.nutOnce.Do(func() {
+		nutation := in.nutation
+		if nutation == nil {
+			nutation = earth.Nutation
+		}
+		in.deltaPsi, in.deltaEps = nutation(in.jd)
+	})
+	return in.deltaPsi, in.deltaEps
+}
+
+// MeanObliquity returns the mean obliquity of the ecliptic ε₀ (radians).
+func (in *Instant) MeanObliquity() float64 {
+	in.oblOnce.Do(func() {
+		in.meanObliquity = earth.Obliquity(in.jd, 0)
+	})
+	return in.meanObliquity
+}
+
+// TrueObliquity returns the true obliquity of the ecliptic ε = ε₀ + Δε (radians).
+func (in *Instant) TrueObliquity() float64 {
+	_, deltaEps := in.Nutation()
+	return in.MeanObliquity() + deltaEps
+}
+
+// SiderealTime returns Greenwich apparent sidereal time (radians).
+func (in *Instant) SiderealTime() float64 {
+	in.sidOnce.Do(func() {
+		deltaPsi, _ := in.Nutation()
+		gst := timeutils.JulianToSidereal(in.ut, timeutils.SiderealOptions{
+			Dpsi: mathutils.Degrees(deltaPsi),
+			Eps:  mathutils.Degrees(in.TrueObliquity()),
+		})
+		in.sidereal = mathutils.Radians(gst * 15)
+	})
+	return in.sidereal
+}
+
+// Offset returns the instant shifted by the given number of days. Offsets
+// are memoized, so that bodies sharing a numerical step also share the
+// neighbouring instants.
+func (in *Instant) Offset(days float64) *Instant {
+	in.mu.Lock()
+	defer in.mu.Unlock()
+	if o, ok := in.offsets[days]; ok {
+		return o
+	}
+	if in.offsets == nil {
+		in.offsets = make(map[float64]*Instant)
+	}
+	o := &Instant{
+		jd:         in.jd + days,
+		ut:         in.ut + days,
+		earthModel: in.earthModel,
+		nutation:   in.nutation,
+	}
+	in.offsets[days] = o
+	return o
+}
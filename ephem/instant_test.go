@@ -0,0 +1,81 @@
+package ephem
+
+import (
+	"testing"
+
+	"github.com/ilbagatto/vsop87-go/earth"
+	"github.com/ilbagatto/vsop87-go/mathutils"
+	"github.com/ilbagatto/vsop87-go/timeutils"
+)
+
+func TestInstantQuantities(t *testing.T) {
+	const jd = 2446895.5 // 1987 April 10 at 0h TD
+	in := NewInstant(jd)
+
+	dpsi, deps := earth.Nutation(jd)
+	gotPsi, gotEps := in.Nutation()
+	if gotPsi != dpsi || gotEps != deps {
+		t.Errorf("Nutation mismatch: (%g, %g) != (%g, %g)", gotPsi, gotEps, dpsi, deps)
+	}
+	if exp := earth.Obliquity(jd, deps); !mathutils.AlmostEqual(in.TrueObliquity(), exp, 1e-15) {
+		t.Errorf("True obliquity should be %.12f. Got: %.12f", exp, in.TrueObliquity())
+	}
+	if exp := timeutils.DeltaT(jd); !mathutils.AlmostEqual(in.DeltaT(), exp, 1e-3) {
+		t.Errorf("ΔT should be %.3f. Got: %.3f", exp, in.DeltaT())
+	}
+
+	// Meeus, p.88: apparent sidereal time at 0h UT is 13h10m46.1351s
+	ut := NewInstantUT(jd)
+	exp := 13 + 10.0/60 + 46.1351/3600
+	got := mathutils.Degrees(ut.SiderealTime()) / 15
+	if !mathutils.AlmostEqual(got, exp, 1e-4) {
+		t.Errorf("Sidereal time should be %.6f. Got: %.6f", exp, got)
+	}
+}
+
+func TestInstantOffsetMemoized(t *testing.T) {
+	in := NewInstant(2451545.0)
+	a := in.Offset(1.0 / 96)
+	b := in.Offset(1.0 / 96)
+	if a != b {
+		t.Errorf("Offsets should be memoized")
+	}
+	if a.JD() != 2451545.0+1.0/96 {
+		t.Errorf("Offset JD should be %.6f. Got: %.6f", 2451545.0+1.0/96, a.JD())
+	}
+}
+
+func TestPositionsAtInstant(t *testing.T) {
+	const jd = 2438792.990277
+	in := NewInstant(jd)
+	dpsi, _ := earth.Nutation(jd)
+	for _, b := range batchBodies {
+		exp, _ := EclipticPosition(b, jd, dpsi)
+		got, err := EclipticPositionAt(b, in)
+		if err != nil {
+			t.Fatalf("EclipticPositionAt(%s): %v", b, err)
+		}
+		if !sameEcl(got, exp) {
+			t.Errorf("%s: %+v != %+v", b, got, exp)
+		}
+	}
+}
+
+// BenchmarkChart computes positions and speeds of all bodies at one moment.
+func BenchmarkChart(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		in := NewInstant(2451545.0 + float64(i))
+		for _, body := range batchBodies {
+			if _, _, err := EclipticPositionWithVelocityAt(body, in); err != nil {
+				b.Fatal(err)
+			}
+		}
+	}
+}
+
+// sameEcl compares ecliptic coordinates up to rounding.
+func sameEcl(a, b EclCoord) bool {
+	return mathutils.AlmostEqual(a.Lambda, b.Lambda, 1e-12) &&
+		mathutils.AlmostEqual(a.Beta, b.Beta, 1e-12) &&
+		mathutils.AlmostEqual(a.Radius, b.Radius, 1e-12)
+}
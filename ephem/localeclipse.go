@@ -0,0 +1,181 @@
+package ephem
+
+import (
+	"math"
+
+	"github.com/ilbagatto/vsop87-go/internal/search"
+)
+
+// localWindow is the half-width of the search for the local circumstances
+// around the greatest eclipse, in days.
+const localWindow = 0.2
+
+// EclipseContact is a moment of a solar eclipse seen by an observer, with
+// the position of the Sun in the sky.
+type EclipseContact struct {
+	JD  float64  // Julian Day (TT); zero if the contact does not occur
+	UT  float64  // Julian Day (UT)
+	Sun HorCoord // azimuth (from North) and altitude of the Sun
+}
+
+// LocalSolarEclipse describes a solar eclipse as seen by an observer.
+type LocalSolarEclipse struct {
+	// Eclipsed is true if the Moon covers part of the Sun at the location,
+	// whether or not the Sun is above the horizon.
+	Eclipsed bool
+	// Visible is true if the Sun is above the horizon at a contact or at
+	// maximum.
+	Visible bool
+	// Type is partial, annular or total; it is never hybrid.
+	Type SolarEclipseType
+
+	C1, C2, Max, C3, C4 EclipseContact // C2 and C3 occur only for an annular or total eclipse
+
+	Magnitude   float64 // fraction of the solar diameter covered at maximum
+	Obscuration float64 // fraction of the solar disk covered at maximum
+}
+
+// diskGeometry is the apparent Sun and Moon seen by an observer, radians.
+type diskGeometry struct {
+	sep     float64 // distance between the centres
+	sunSD   float64 // semidiameter of the Sun
+	moonSD  float64 // semidiameter of the Moon (mean limb)
+	moonSDu float64 // semidiameter of the Moon for the inner contacts (limb valleys)
+	jd      float64
+}
+
+// LocalCircumstances returns the circumstances of a solar eclipse, found by
+// SolarEclipses, for an observer. The contacts are computed from the
+// topocentric positions of the Sun and the Moon.
+func LocalCircumstances(ecl SolarEclipse, obs Observer) (LocalSolarEclipse, error) {
+	return defaultEphemeris.LocalCircumstances(ecl, obs)
+}
+
+// LocalCircumstances returns the circumstances of a solar eclipse for an
+// observer, using the ephemeris.
+func (e *Ephemeris) LocalCircumstances(ecl SolarEclipse, obs Observer) (LocalSolarEclipse, error) {
+	sep := func(jd float64) (float64, error) {
+		g, err := e.disksAt(jd, obs)
+		return g.sep, err
+	}
+	a, b := ecl.Greatest-localWindow, ecl.Greatest+localWindow
+	roots, err := search.Roots(search.Derivative(sep, 1.0/1440), a, b, 0.02, crossingTol, 0)
+	if err != nil {
+		return LocalSolarEclipse{}, err
+	}
+	var g diskGeometry
+	found := false
+	for _, r := range roots {
+		if !r.Rising {
+			continue
+		}
+		gr, err := e.disksAt(r.JD, obs)
+		if err != nil {
+			return LocalSolarEclipse{}, err
+		}
+		if !found || gr.sep < g.sep {
+			g, found = gr, true
+		}
+	}
+	var res LocalSolarEclipse
+	if !found || g.sep >= g.sunSD+g.moonSD {
+		return res, nil
+	}
+
+	res.Eclipsed = true
+	res.Magnitude = (g.sunSD + g.moonSD - g.sep) / (2 * g.sunSD)
+	res.Obscuration = obscuration(g.sunSD, g.moonSD, g.sep)
+	inner := g.sep < math.Abs(g.sunSD-g.moonSDu)
+	switch {
+	case inner && g.moonSDu > g.sunSD:
+		res.Type = TotalSolarEclipse
+	case inner:
+		res.Type = AnnularSolarEclipse
+	default:
+		res.Type = PartialSolarEclipse
+	}
+	if res.Max, err = e.eclipseContact(g.jd, obs); err != nil {
+		return LocalSolarEclipse{}, err
+	}
+
+	contacts := []struct {
+		first, last *EclipseContact
+		limit       func(diskGeometry) float64
+	}{
+		{&res.C1, &res.C4, func(g diskGeometry) float64 { return g.sunSD + g.moonSD }},
+		{&res.C2, &res.C3, func(g diskGeometry) float64 { return math.Abs(g.sunSD - g.moonSDu) }},
+	}
+	for i, c := range contacts {
+		if i == 1 && !inner {
+			break
+		}
+		f := func(jd float64) (float64, error) {
+			g, err := e.disksAt(jd, obs)
+			return g.sep - c.limit(g), err
+		}
+		first, err := contact(f, g.jd-localWindow, g.jd)
+		if err != nil {
+			return LocalSolarEclipse{}, err
+		}
+		last, err := contact(f, g.jd, g.jd+localWindow)
+		if err != nil {
+			return LocalSolarEclipse{}, err
+		}
+		if *c.first, err = e.eclipseContact(first, obs); err != nil {
+			return LocalSolarEclipse{}, err
+		}
+		if *c.last, err = e.eclipseContact(last, obs); err != nil {
+			return LocalSolarEclipse{}, err
+		}
+	}
+	for _, c := range []EclipseContact{res.C1, res.C2, res.Max, res.C3, res.C4} {
+		if c.JD != 0 && c.Sun.Altitude > 0 {
+			res.Visible = true
+		}
+	}
+	return res, nil
+}
+
+// eclipseContact returns the contact at jd (TT) with the position of the Sun.
+func (e *Ephemeris) eclipseContact(jd float64, obs Observer) (EclipseContact, error) {
+	ut := e.NewInstant(jd).UT()
+	sun, err := e.HorizontalPosition(Sun, ut, obs, HorizontalOptions{})
+	return EclipseContact{JD: jd, UT: ut, Sun: sun}, err
+}
+
+// disksAt returns the apparent Sun and Moon seen by the observer at jd (TT).
+func (e *Ephemeris) disksAt(jd float64, obs Observer) (diskGeometry, error) {
+	in := e.NewInstant(jd)
+	sun, err := e.TopocentricEquatorialPositionAt(Sun, in, obs)
+	if err != nil {
+		return diskGeometry{}, err
+	}
+	moon, err := e.TopocentricEquatorialPositionAt(Moon, in, obs)
+	if err != nil {
+		return diskGeometry{}, err
+	}
+	cosSep := math.Sin(sun.Delta)*math.Sin(moon.Delta) +
+		math.Cos(sun.Delta)*math.Cos(moon.Delta)*math.Cos(sun.Alpha-moon.Alpha)
+	return diskGeometry{
+		sep:     math.Acos(math.Max(-1, math.Min(1, cosSep))),
+		sunSD:   angularRadius(sunRadius, sun.Radius),
+		moonSD:  angularRadius(moonRadius, moon.Radius),
+		moonSDu: angularRadius(moonRadiusValleys, moon.Radius),
+		jd:      jd,
+	}, nil
+}
+
+// obscuration returns the fraction of the area of a disk of radius r1
+// covered by a disk of radius r2 at distance d.
+func obscuration(r1, r2, d float64) float64 {
+	switch {
+	case d >= r1+r2:
+		return 0
+	case d <= math.Abs(r1-r2):
+		return math.Min(1, r2*r2/(r1*r1))
+	}
+	a1 := r1 * r1 * math.Acos((d*d+r1*r1-r2*r2)/(2*d*r1))
+	a2 := r2 * r2 * math.Acos((d*d+r2*r2-r1*r1)/(2*d*r2))
+	k := math.Sqrt((-d + r1 + r2) * (d + r1 - r2) * (d - r1 + r2) * (d + r1 + r2))
+	return (a1 + a2 - k/2) / (math.Pi * r1 * r1)
+}
@@ -0,0 +1,76 @@
+package ephem
+
+import (
+	"math"
+	"testing"
+
+	"github.com/ilbagatto/vsop87-go/mathutils"
+)
+
+func TestLocalCircumstances(t *testing.T) {
+	ecls, err := SolarEclipses(2460400.5, 2460420.5)
+	if err != nil || len(ecls) != 1 {
+		t.Fatalf("Expected the eclipse of 2024 April 8. Got: %v, %v", ecls, err)
+	}
+	// Dallas, Texas (NASA): partial from 17:23 to 20:02 UT, totality 18:40:43–18:44:35 UT
+	dallas := Observer{Lat: mathutils.Radians(32.7767), Lng: mathutils.Radians(-96.797)}
+	got, err := LocalCircumstances(ecls[0], dallas)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !got.Eclipsed || !got.Visible || got.Type != TotalSolarEclipse {
+		t.Fatalf("Eclipse should be total and visible. Got: %+v", got)
+	}
+	hm := func(h, m, s float64) float64 { return 2460408.5 + (h+m/60+s/3600)/24 }
+	contacts := []struct {
+		name string
+		c    EclipseContact
+		exp  float64
+	}{
+		{"C1", got.C1, hm(17, 23, 0)},
+		{"C2", got.C2, hm(18, 40, 43)},
+		{"C3", got.C3, hm(18, 44, 35)},
+		{"C4", got.C4, hm(20, 2, 0)},
+	}
+	for _, tc := range contacts {
+		if math.Abs(tc.c.UT-tc.exp) > 1.5/1440 {
+			t.Errorf("%s should be at %.5f UT. Got: %.5f", tc.name, tc.exp, tc.c.UT)
+		}
+		if tc.c.Sun.Altitude < mathutils.Radians(50) {
+			t.Errorf("%s: the Sun should be high. Got altitude %.2f°", tc.name, mathutils.Degrees(tc.c.Sun.Altitude))
+		}
+	}
+	if got.Max.JD <= got.C2.JD || got.Max.JD >= got.C3.JD {
+		t.Errorf("Maximum should fall within totality")
+	}
+	if got.Magnitude <= 1 || got.Obscuration != 1 {
+		t.Errorf("Magnitude should exceed 1 and obscuration be 1. Got: %.4f, %.4f", got.Magnitude, got.Obscuration)
+	}
+
+	// the Sun is below the horizon in Sydney
+	sydney := Observer{Lat: mathutils.Radians(-33.87), Lng: mathutils.Radians(151.21)}
+	if got, err = LocalCircumstances(ecls[0], sydney); err != nil || got.Visible {
+		t.Errorf("Eclipse should not be visible from Sydney. Got: %+v, %v", got, err)
+	}
+	// Buenos Aires is outside the penumbra
+	baires := Observer{Lat: mathutils.Radians(-34.6), Lng: mathutils.Radians(-58.4)}
+	if got, err = LocalCircumstances(ecls[0], baires); err != nil || got.Eclipsed {
+		t.Errorf("Eclipse should not occur in Buenos Aires. Got: %+v, %v", got, err)
+	}
+}
+
+func TestObscuration(t *testing.T) {
+	tests := []struct {
+		r1, r2, d, exp float64
+	}{
+		{1, 1, 2, 0},
+		{1, 1.1, 0, 1},
+		{1, 0.5, 0.1, 0.25},
+		{1, 1, 1, (2*math.Pi/3 - math.Sqrt(3)/2) / math.Pi},
+	}
+	for _, tc := range tests {
+		if got := obscuration(tc.r1, tc.r2, tc.d); !mathutils.AlmostEqual(got, tc.exp, 1e-12) {
+			t.Errorf("obscuration(%g, %g, %g) should be %.12f. Got: %.12f", tc.r1, tc.r2, tc.d, tc.exp, got)
+		}
+	}
+}
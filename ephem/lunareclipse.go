@@ -0,0 +1,212 @@
+package ephem
+
+import (
+	"math"
+
+	"github.com/ilbagatto/vsop87-go/internal/search"
+	"github.com/ilbagatto/vsop87-go/mathutils"
+)
+
+const (
+	// shadowEnlargement accounts for the Earth's atmosphere: the Earth's
+	// radius, hence the lunar parallax, is increased by 1/85 (Danjon).
+	shadowEnlargement = 1 + 1.0/85
+	// eclipseWindow is the half-width of the search for contacts around the
+	// greatest eclipse, in days; no lunar eclipse lasts longer than 6.5 hours.
+	eclipseWindow = 0.25
+)
+
+// LunarEclipseType is the kind of a lunar eclipse.
+type LunarEclipseType int
+
+const (
+	// PenumbralLunarEclipse: the Moon enters the penumbra only.
+	PenumbralLunarEclipse LunarEclipseType = iota
+	// PartialLunarEclipse: the Moon is partly immersed in the umbra.
+	PartialLunarEclipse
+	// TotalLunarEclipse: the Moon is entirely immersed in the umbra.
+	TotalLunarEclipse
+)
+
+var lunarEclipseNames = [...]string{"penumbral", "partial", "total"}
+
+func (t LunarEclipseType) String() string {
+	if t < 0 || int(t) >= len(lunarEclipseNames) {
+		return "unknown"
+	}
+	return lunarEclipseNames[t]
+}
+
+// LunarEclipse describes a lunar eclipse. All times are Julian Days (TT);
+// a zero time means that the contact does not occur, e.g. U2 and U3 of a
+// partial eclipse.
+type LunarEclipse struct {
+	Type     LunarEclipseType
+	Greatest float64 // greatest eclipse
+
+	P1, U1, U2 float64 // first contacts with the penumbra and umbra, beginning of totality
+	U3, U4, P4 float64 // end of totality, last contacts with the umbra and penumbra
+
+	UmbralMagnitude    float64 // fraction of the lunar diameter in the umbra, negative if none
+	PenumbralMagnitude float64 // fraction of the lunar diameter in the penumbra
+	Gamma              float64 // least distance of the Moon's center from the shadow axis, Earth radii; positive north
+}
+
+// shadowGeometry is the position of the Moon relative to the Earth's
+// shadow at a moment, all angles in radians as seen from the Earth's center.
+type shadowGeometry struct {
+	sep       float64 // distance of the Moon's center from the shadow axis
+	moonSD    float64 // semidiameter of the Moon
+	umbra     float64 // radius of the umbra
+	penumbra  float64 // radius of the penumbra
+	parallax  float64 // horizontal parallax of the Moon
+	northward bool    // the Moon is north of the shadow axis
+}
+
+// LunarEclipses returns the lunar eclipses between start and end (Julian
+// Days, TT), in chronological order.
+func LunarEclipses(start, end float64) ([]LunarEclipse, error) {
+	return defaultEphemeris.LunarEclipses(start, end)
+}
+
+// LunarEclipses returns the lunar eclipses between start and end, using the
+// ephemeris. The reduction should be apparent and the frame of date.
+//
+// Every Full Moon is examined: the distance of the Moon from the shadow
+// axis is minimized around it, and the contacts are the moments when this
+// distance equals the sum or difference of the radii of the Moon and the
+// shadow.
+func (e *Ephemeris) LunarEclipses(start, end float64) ([]LunarEclipse, error) {
+	phases, err := e.LunarPhases(start, end)
+	if err != nil {
+		return nil, err
+	}
+	var res []LunarEclipse
+	for _, p := range phases {
+		if p.Phase != FullMoon {
+			continue
+		}
+		ecl, ok, err := e.lunarEclipse(p.JD)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			res = append(res, ecl)
+		}
+	}
+	return res, nil
+}
+
+// lunarEclipse examines the Full Moon at jd and reports whether there is
+// an eclipse.
+func (e *Ephemeris) lunarEclipse(jd float64) (LunarEclipse, bool, error) {
+	// at Full Moon, the Moon is too far from the shadow for an eclipse
+	g, err := e.shadowAt(jd)
+	if err != nil || g.sep > 2*g.penumbra {
+		return LunarEclipse{}, false, err
+	}
+
+	sep := func(t float64) (float64, error) {
+		g, err := e.shadowAt(t)
+		return g.sep, err
+	}
+	roots, err := search.Roots(search.Derivative(sep, 1.0/1440), jd-0.2, jd+0.2, 0.05, crossingTol, 0)
+	if err != nil {
+		return LunarEclipse{}, false, err
+	}
+	greatest := jd
+	for _, r := range roots {
+		if r.Rising {
+			greatest = r.JD
+			break
+		}
+	}
+	g, err = e.shadowAt(greatest)
+	if err != nil {
+		return LunarEclipse{}, false, err
+	}
+	ecl := LunarEclipse{
+		Greatest:           greatest,
+		UmbralMagnitude:    (g.umbra + g.moonSD - g.sep) / (2 * g.moonSD),
+		PenumbralMagnitude: (g.penumbra + g.moonSD - g.sep) / (2 * g.moonSD),
+		Gamma:              math.Sin(g.sep) / math.Sin(g.parallax),
+	}
+	if ecl.PenumbralMagnitude <= 0 {
+		return LunarEclipse{}, false, nil
+	}
+	if !g.northward {
+		ecl.Gamma = -ecl.Gamma
+	}
+	switch {
+	case ecl.UmbralMagnitude >= 1:
+		ecl.Type = TotalLunarEclipse
+	case ecl.UmbralMagnitude > 0:
+		ecl.Type = PartialLunarEclipse
+	default:
+		ecl.Type = PenumbralLunarEclipse
+	}
+
+	contacts := []struct {
+		first, last *float64
+		limit       func(shadowGeometry) float64
+	}{
+		{&ecl.P1, &ecl.P4, func(g shadowGeometry) float64 { return g.penumbra + g.moonSD }},
+		{&ecl.U1, &ecl.U4, func(g shadowGeometry) float64 { return g.umbra + g.moonSD }},
+		{&ecl.U2, &ecl.U3, func(g shadowGeometry) float64 { return g.umbra - g.moonSD }},
+	}
+	for i, c := range contacts {
+		if i == 1 && ecl.Type == PenumbralLunarEclipse || i == 2 && ecl.Type != TotalLunarEclipse {
+			break
+		}
+		f := func(t float64) (float64, error) {
+			g, err := e.shadowAt(t)
+			return g.sep - c.limit(g), err
+		}
+		if *c.first, err = contact(f, greatest-eclipseWindow, greatest); err != nil {
+			return LunarEclipse{}, false, err
+		}
+		if *c.last, err = contact(f, greatest, greatest+eclipseWindow); err != nil {
+			return LunarEclipse{}, false, err
+		}
+	}
+	return ecl, true, nil
+}
+
+// contact returns the zero of f in [a, b], where f changes sign once.
+func contact(f search.Func, a, b float64) (float64, error) {
+	fa, err := f(a)
+	if err != nil {
+		return 0, err
+	}
+	fb, err := f(b)
+	if err != nil {
+		return 0, err
+	}
+	return search.Refine(f, a, fa, b, fb, crossingTol)
+}
+
+// shadowAt returns the position of the Moon relative to the Earth's shadow
+// at jd (TT).
+func (e *Ephemeris) shadowAt(jd float64) (shadowGeometry, error) {
+	in := e.NewInstant(jd)
+	moon, err := e.EclipticPositionAt(Moon, in)
+	if err != nil {
+		return shadowGeometry{}, err
+	}
+	sun, err := e.EclipticPositionAt(Sun, in)
+	if err != nil {
+		return shadowGeometry{}, err
+	}
+	axis := EclCoord{Lambda: mathutils.ReduceRad(sun.Lambda + math.Pi), Beta: -sun.Beta, Radius: sun.Radius}
+	pm := HorizontalParallax(moon.Radius)
+	ps := HorizontalParallax(sun.Radius)
+	ss := angularRadius(sunRadius, sun.Radius)
+	return shadowGeometry{
+		sep:       elongation(moon, axis),
+		moonSD:    angularRadius(moonRadius, moon.Radius),
+		umbra:     shadowEnlargement*pm + ps - ss,
+		penumbra:  shadowEnlargement*pm + ps + ss,
+		parallax:  pm,
+		northward: moon.Beta > axis.Beta,
+	}, nil
+}
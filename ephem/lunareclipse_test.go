@@ -0,0 +1,125 @@
+package ephem
+
+import (
+	"math"
+	"testing"
+
+	"github.com/ilbagatto/vsop87-go/mathutils"
+)
+
+func TestLunarEclipseTotal(t *testing.T) {
+	// NASA: contact times in UT, ΔT = 69.2 s
+	const dt = 69.2 / 86400
+	cases := []struct {
+		date               float64    // 0h UT of the date
+		contacts           [7]float64 // P1, U1, U2, greatest, U3, U4, P4; hours UT
+		umbral, pen, gamma float64
+	}{
+		{2459715.5, // 2022 May 16
+			[7]float64{hours(1, 32, 7), hours(2, 27, 52), hours(3, 29, 3), hours(4, 11, 28),
+				hours(4, 53, 55), hours(5, 55, 7), hours(6, 50, 49)},
+			1.4137, 2.3726, -0.2532},
+		{2459891.5, // 2022 November 8
+			[7]float64{hours(8, 2, 17), hours(9, 9, 12), hours(10, 16, 39), hours(10, 59, 11),
+				hours(11, 41, 35), hours(12, 49, 3), hours(13, 56, 8)},
+			1.3589, 2.4151, 0.2570},
+	}
+	for _, c := range cases {
+		got, err := LunarEclipses(c.date-5, c.date+5)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(got) != 1 {
+			t.Fatalf("Expected 1 eclipse. Got: %v", got)
+		}
+		ecl := got[0]
+		if ecl.Type != TotalLunarEclipse {
+			t.Errorf("Eclipse of %.1f should be total. Got: %s", c.date, ecl.Type)
+		}
+		checkContacts(t, ecl)
+		names := []string{"P1", "U1", "U2", "Greatest", "U3", "U4", "P4"}
+		for i, v := range []float64{ecl.P1, ecl.U1, ecl.U2, ecl.Greatest, ecl.U3, ecl.U4, ecl.P4} {
+			exp := c.date + c.contacts[i]/24 + dt
+			if math.Abs(v-exp) > 1.0/1440 {
+				t.Errorf("%.1f: %s should be at %.5f. Got: %.5f", c.date, names[i], exp, v)
+			}
+		}
+		if !mathutils.AlmostEqual(ecl.UmbralMagnitude, c.umbral, 0.005) {
+			t.Errorf("%.1f: umbral magnitude should be %.4f. Got: %.4f", c.date, c.umbral, ecl.UmbralMagnitude)
+		}
+		if !mathutils.AlmostEqual(ecl.PenumbralMagnitude, c.pen, 0.005) {
+			t.Errorf("%.1f: penumbral magnitude should be %.4f. Got: %.4f", c.date, c.pen, ecl.PenumbralMagnitude)
+		}
+		if !mathutils.AlmostEqual(ecl.Gamma, c.gamma, 0.001) {
+			t.Errorf("%.1f: gamma should be %.4f. Got: %.4f", c.date, c.gamma, ecl.Gamma)
+		}
+	}
+}
+
+func TestLunarEclipses2023(t *testing.T) {
+	// penumbral May 5 and partial October 28 (NASA)
+	exp := []struct {
+		typ                LunarEclipseType
+		umbral, pen, gamma float64
+	}{
+		{PenumbralLunarEclipse, -0.0431, 0.9638, -1.0350},
+		{PartialLunarEclipse, 0.1224, 1.1178, 0.9472},
+	}
+	got, err := LunarEclipses(2459945.5, 2460310.5)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != len(exp) {
+		t.Fatalf("Expected %d eclipses. Got: %v", len(exp), got)
+	}
+	for i, ecl := range got {
+		if ecl.Type != exp[i].typ {
+			t.Errorf("Eclipse #%d should be %s. Got: %s", i, exp[i].typ, ecl.Type)
+		}
+		if !mathutils.AlmostEqual(ecl.UmbralMagnitude, exp[i].umbral, 0.005) ||
+			!mathutils.AlmostEqual(ecl.PenumbralMagnitude, exp[i].pen, 0.005) ||
+			!mathutils.AlmostEqual(ecl.Gamma, exp[i].gamma, 0.001) {
+			t.Errorf("Eclipse #%d: magnitudes and gamma should be %+v. Got: %+v", i, exp[i], ecl)
+		}
+		checkContacts(t, ecl)
+	}
+}
+
+// hours converts a time of day to hours.
+func hours(h, m, s float64) float64 {
+	return h + m/60 + s/3600
+}
+
+// checkContacts verifies that the contacts present match the type of the
+// eclipse: a penumbral eclipse has no umbral contacts, a partial one has U1
+// and U4 only, a total one has all four. The contacts must be in order.
+func checkContacts(t *testing.T, ecl LunarEclipse) {
+	t.Helper()
+	umbral := ecl.U1 != 0 && ecl.U4 != 0
+	total := ecl.U2 != 0 && ecl.U3 != 0
+	switch ecl.Type {
+	case PenumbralLunarEclipse:
+		if ecl.U1 != 0 || ecl.U2 != 0 || ecl.U3 != 0 || ecl.U4 != 0 {
+			t.Errorf("Penumbral eclipse should have no contacts U1–U4. Got: %+v", ecl)
+		}
+	case PartialLunarEclipse:
+		if !umbral || ecl.U2 != 0 || ecl.U3 != 0 {
+			t.Errorf("Partial eclipse should have U1 and U4 but no U2, U3. Got: %+v", ecl)
+		}
+	case TotalLunarEclipse:
+		if !umbral || !total {
+			t.Errorf("Total eclipse should have contacts U1–U4. Got: %+v", ecl)
+		}
+	}
+	var prev float64
+	for _, v := range []float64{ecl.P1, ecl.U1, ecl.U2, ecl.Greatest, ecl.U3, ecl.U4, ecl.P4} {
+		if v == 0 {
+			continue
+		}
+		if v <= prev {
+			t.Errorf("Contacts should be in chronological order. Got: %+v", ecl)
+			break
+		}
+		prev = v
+	}
+}
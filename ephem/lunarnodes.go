@@ -0,0 +1,80 @@
+package ephem
+
+import "github.com/ilbagatto/vsop87-go/internal/search"
+
+// NodePassage is a moment when the Moon crosses the ecliptic.
+type NodePassage struct {
+	JD        float64 // Julian Day (TT)
+	Ascending bool    // the Moon passes from south to north
+	Lambda    float64 // longitude of the Moon, radians
+}
+
+// DeclinationExtreme is a moment of greatest northern or southern
+// declination of the Moon.
+type DeclinationExtreme struct {
+	JD    float64 // Julian Day (TT)
+	North bool
+	Delta float64 // declination, radians
+}
+
+// MoonNodePassages returns the passages of the Moon through the ascending
+// and descending nodes of its orbit between start and end (Julian Days,
+// TT), in chronological order (Meeus, ch. 51). They are the zeros of the
+// Moon's ecliptic latitude.
+func MoonNodePassages(start, end float64) ([]NodePassage, error) {
+	return defaultEphemeris.MoonNodePassages(start, end)
+}
+
+// MoonNodePassages returns the passages of the Moon through the nodes
+// between start and end, using the ephemeris.
+func (e *Ephemeris) MoonNodePassages(start, end float64) ([]NodePassage, error) {
+	beta := func(jd float64) (float64, error) {
+		pos, err := e.EclipticPositionAt(Moon, e.NewInstant(jd))
+		return pos.Beta, err
+	}
+	// passages are about two weeks apart
+	roots, err := search.Roots(beta, start, end, 1, crossingTol, 0)
+	if err != nil {
+		return nil, err
+	}
+	res := make([]NodePassage, len(roots))
+	for i, r := range roots {
+		pos, err := e.EclipticPositionAt(Moon, e.NewInstant(r.JD))
+		if err != nil {
+			return nil, err
+		}
+		res[i] = NodePassage{JD: r.JD, Ascending: r.Rising, Lambda: pos.Lambda}
+	}
+	return res, nil
+}
+
+// MoonDeclinationExtremes returns the moments of greatest northern and
+// southern declination of the Moon between start and end (Julian Days,
+// TT), in chronological order (Meeus, ch. 52). They are the zeros of the
+// rate of the apparent geocentric declination.
+func MoonDeclinationExtremes(start, end float64) ([]DeclinationExtreme, error) {
+	return defaultEphemeris.MoonDeclinationExtremes(start, end)
+}
+
+// MoonDeclinationExtremes returns the moments of greatest declination of
+// the Moon between start and end, using the ephemeris.
+func (e *Ephemeris) MoonDeclinationExtremes(start, end float64) ([]DeclinationExtreme, error) {
+	rate := func(jd float64) (float64, error) {
+		_, vel, err := e.EquatorialStateAt(Moon, e.NewInstant(jd))
+		return vel.Delta, err
+	}
+	roots, err := search.Roots(rate, start, end, 1, crossingTol, 0)
+	if err != nil {
+		return nil, err
+	}
+	res := make([]DeclinationExtreme, len(roots))
+	for i, r := range roots {
+		pos, err := e.EquatorialPositionAt(Moon, e.NewInstant(r.JD))
+		if err != nil {
+			return nil, err
+		}
+		// a falling zero of the rate is a maximum
+		res[i] = DeclinationExtreme{JD: r.JD, North: !r.Rising, Delta: pos.Delta}
+	}
+	return res, nil
+}
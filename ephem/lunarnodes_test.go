@@ -0,0 +1,48 @@
+package ephem
+
+import (
+	"math"
+	"testing"
+
+	"github.com/ilbagatto/vsop87-go/mathutils"
+)
+
+func TestMoonNodePassages(t *testing.T) {
+	// Meeus, example 51.a: ascending node, 1987 May 23 at JDE 2446938.76803
+	got, err := MoonNodePassages(2446925.0, 2446955.0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != 3 {
+		t.Fatalf("Expected 3 passages. Got: %v", got)
+	}
+	if got[0].Ascending || !got[1].Ascending || got[2].Ascending {
+		t.Errorf("Passages should alternate. Got: %v", got)
+	}
+	asc := got[1]
+	if math.Abs(asc.JD-2446938.76803) > 0.005 {
+		t.Errorf("Ascending node should be at 2446938.76803. Got: %.5f", asc.JD)
+	}
+	if d := math.Remainder(asc.Lambda-Node(asc.JD, true), Pi2); math.Abs(d) > mathutils.Radians(0.1) {
+		t.Errorf("Moon should be at the true node. Off by %.4f°", mathutils.Degrees(d))
+	}
+}
+
+func TestMoonDeclinationExtremes(t *testing.T) {
+	// Meeus, example 52.a: greatest northern declination 1988 December 22,
+	// JDE 2447518.3346, δ = +28°09′01″ (mean values, accurate to a few minutes)
+	got, err := MoonDeclinationExtremes(2447510.5, 2447530.5)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != 1 || !got[0].North {
+		t.Fatalf("Expected a northern extreme. Got: %v", got)
+	}
+	north := got[0]
+	if math.Abs(north.JD-2447518.3346) > 0.01 {
+		t.Errorf("Greatest northern declination should be at 2447518.3346. Got: %.4f", north.JD)
+	}
+	if exp := 28 + 9.0/60 + 1.0/3600; !mathutils.AlmostEqual(mathutils.Degrees(north.Delta), exp, 0.01) {
+		t.Errorf("Declination should be %.4f. Got: %.4f", exp, mathutils.Degrees(north.Delta))
+	}
+}
@@ -0,0 +1,147 @@
+package ephem
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/ilbagatto/vsop87-go/internal/search"
+	"github.com/ilbagatto/vsop87-go/mathutils"
+)
+
+const (
+	// SynodicMonth is the mean length of a lunation, in days (Meeus, ch. 49).
+	SynodicMonth = 29.530588861
+	// lunation0 is the mean New Moon of 2000 January 6, lunation 0.
+	lunation0 = 2451550.09766
+	// BrownLunationOffset converts a Meeus lunation number to the Brown one,
+	// which counts from the New Moon of 1923 January 16.
+	BrownLunationOffset = 953
+)
+
+// LunarPhase is one of the four principal phases of the Moon.
+type LunarPhase int
+
+const (
+	// NewMoon: the Moon and the Sun have the same longitude.
+	NewMoon LunarPhase = iota
+	// FirstQuarter: the Moon is 90° east of the Sun.
+	FirstQuarter
+	// FullMoon: the Moon is opposite the Sun.
+	FullMoon
+	// LastQuarter: the Moon is 90° west of the Sun.
+	LastQuarter
+)
+
+var lunarPhaseNames = [...]string{"New Moon", "First Quarter", "Full Moon", "Last Quarter"}
+
+func (p LunarPhase) String() string {
+	if p < 0 || int(p) >= len(lunarPhaseNames) {
+		return "unknown"
+	}
+	return lunarPhaseNames[p]
+}
+
+// LunarPhaseEvent is the moment of a principal phase: the excess of the
+// apparent longitude of the Moon over that of the Sun is 0°, 90°, 180° or
+// 270°.
+type LunarPhaseEvent struct {
+	JD       float64 // Julian Day (TT)
+	Phase    LunarPhase
+	Lunation int // Meeus lunation number, 0 for the lunation beginning 2000 January 6
+}
+
+// MoonPhase describes the phase of the Moon at a given moment.
+type MoonPhase struct {
+	Elongation  float64 // λ☾ − λ☉, radians in [0, 2π)
+	PhaseAngle  float64 // Sun–Moon–Earth angle i, radians (Meeus 48.3)
+	Illuminated float64 // illuminated fraction of the disk, (1 + cos i) / 2
+	Age         float64 // days since the last New Moon
+	Lunation    int     // Meeus lunation number; add BrownLunationOffset for Brown's
+}
+
+// LunarPhases returns the principal phases of the Moon between start and
+// end (Julian Days, TT), in chronological order.
+func LunarPhases(start, end float64) ([]LunarPhaseEvent, error) {
+	return defaultEphemeris.LunarPhases(start, end)
+}
+
+// LunarPhases returns the principal phases of the Moon between start and
+// end, using the reduction and frame of the ephemeris.
+func (e *Ephemeris) LunarPhases(start, end float64) ([]LunarPhaseEvent, error) {
+	targets := []float64{0, math.Pi / 2, math.Pi, -math.Pi / 2}
+	res, err := e.phaseCrossings(start, end, targets)
+	if err != nil {
+		return nil, err
+	}
+	events := make([]LunarPhaseEvent, len(res))
+	for i, c := range res {
+		q := int(math.Round(mathutils.ReduceRad(c.Target)/(math.Pi/2))) % 4
+		events[i] = LunarPhaseEvent{
+			JD:       c.JD,
+			Phase:    LunarPhase(q),
+			Lunation: lunationNumber(c.JD - float64(q)*SynodicMonth/4),
+		}
+	}
+	return events, nil
+}
+
+// MoonPhaseAt returns the phase of the Moon at jd (TT).
+func MoonPhaseAt(jd float64) (MoonPhase, error) {
+	return defaultEphemeris.MoonPhaseAt(jd)
+}
+
+// MoonPhaseAt returns the phase of the Moon at jd (TT), using the reduction
+// and frame of the ephemeris.
+func (e *Ephemeris) MoonPhaseAt(jd float64) (MoonPhase, error) {
+	in := e.NewInstant(jd)
+	moon, err := e.EclipticPositionAt(Moon, in)
+	if err != nil {
+		return MoonPhase{}, err
+	}
+	sun, err := e.EclipticPositionAt(Sun, in)
+	if err != nil {
+		return MoonPhase{}, err
+	}
+	// the last New Moon is less than a synodic month ago
+	res, err := e.phaseCrossings(jd-SynodicMonth-1, jd, []float64{0})
+	if err != nil {
+		return MoonPhase{}, err
+	}
+	if len(res) == 0 {
+		return MoonPhase{}, fmt.Errorf("ephem: no New Moon in the synodic month before JD %.5f", jd)
+	}
+	newMoon := res[len(res)-1].JD
+
+	// Meeus 48.2 and 48.3
+	psi := elongation(moon, sun)
+	i := math.Atan2(sun.Radius*math.Sin(psi), moon.Radius-sun.Radius*math.Cos(psi))
+	return MoonPhase{
+		Elongation:  mathutils.ReduceRad(moon.Lambda - sun.Lambda),
+		PhaseAngle:  i,
+		Illuminated: (1 + math.Cos(i)) / 2,
+		Age:         jd - newMoon,
+		Lunation:    lunationNumber(newMoon),
+	}, nil
+}
+
+// phaseCrossings returns the moments when λ☾ − λ☉ passes the targets. The
+// difference always increases, by about 12° a day.
+func (e *Ephemeris) phaseCrossings(start, end float64, targets []float64) ([]search.Crossing, error) {
+	moon, sun := e.bodyLongitude(Moon), e.bodyLongitude(Sun)
+	diff := func(jd float64) (float64, error) {
+		lm, _, err := moon(jd)
+		if err != nil {
+			return 0, err
+		}
+		ls, _, err := sun(jd)
+		return lm - ls, err
+	}
+	return search.AngleCrossings(diff, targets, search.Grid(start, end, 1), crossingTol)
+}
+
+// lunationNumber returns the Meeus number of the lunation beginning with
+// the New Moon closest to jd. True New Moons differ from the mean ones by
+// less than a day.
+func lunationNumber(jd float64) int {
+	return int(math.Round((jd - lunation0) / SynodicMonth))
+}
@@ -0,0 +1,60 @@
+package ephem
+
+import (
+	"math"
+	"testing"
+
+	"github.com/ilbagatto/vsop87-go/mathutils"
+)
+
+func TestLunarPhases(t *testing.T) {
+	// Meeus, example 49.a: New Moon 1977 February 18, 3h37m42s TD, k = −283
+	const newMoon = 2443192.65118
+	got, err := LunarPhases(newMoon-1, newMoon+SynodicMonth)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != 4 {
+		t.Fatalf("Expected 4 phases. Got: %v", got)
+	}
+	for i, ev := range got {
+		if ev.Phase != LunarPhase(i) || ev.Lunation != -283 {
+			t.Errorf("Event #%d should be %s of lunation -283. Got: %+v", i, LunarPhase(i), ev)
+		}
+	}
+	if math.Abs(got[0].JD-newMoon) > 1.0/1440 {
+		t.Errorf("New Moon should be at %.5f. Got: %.5f", newMoon, got[0].JD)
+	}
+}
+
+func TestMoonPhaseAt(t *testing.T) {
+	// Meeus, example 48.a: 1992 April 12, 0h TD
+	got, err := MoonPhaseAt(2448724.5)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if exp := 69.0756; !mathutils.AlmostEqual(mathutils.Degrees(got.PhaseAngle), exp, 1e-3) {
+		t.Errorf("Phase angle should be %.4f. Got: %.4f", exp, mathutils.Degrees(got.PhaseAngle))
+	}
+	if exp := 0.6786; !mathutils.AlmostEqual(got.Illuminated, exp, 1e-4) {
+		t.Errorf("Illuminated fraction should be %.4f. Got: %.4f", exp, got.Illuminated)
+	}
+	// New Moon of 1992 April 3, 5:01 UT, lunation -96 (Brown 857)
+	if exp := 2448724.5 - 2448715.7097; math.Abs(got.Age-exp) > 0.01 {
+		t.Errorf("Age should be %.2f. Got: %.2f", exp, got.Age)
+	}
+	if got.Lunation != -96 || got.Lunation+BrownLunationOffset != 857 {
+		t.Errorf("Lunation should be -96. Got: %d", got.Lunation)
+	}
+}
+
+func TestMoonPhaseAtNoNewMoon(t *testing.T) {
+	// with the Moon fixed opposite the Sun the elongation never passes 0
+	e := New(
+		WithBackend(Sun, fixedComputer(EclCoord{Lambda: 0, Radius: 1})),
+		WithBackend(Moon, fixedComputer(EclCoord{Lambda: math.Pi, Radius: 0.0026})),
+	)
+	if _, err := e.MoonPhaseAt(2451545.0); err == nil {
+		t.Error("Expected an error when no New Moon is found")
+	}
+}
@@ -0,0 +1,25 @@
+package ephem
+
+import "github.com/ilbagatto/vsop87-go/earth"
+
+// Observer is a location on the surface of the Earth.
+type Observer struct {
+	Lat       float64         // geodetic latitude, radians (positive north)
+	Lng       float64         // longitude, radians (negative westwards)
+	Elevation float64         // height above the ellipsoid, meters
+	Ellipsoid earth.Ellipsoid // reference ellipsoid; zero value means WGS84
+}
+
+// ParallaxFactors returns ρ·sin φ′ and ρ·cos φ′ of the observer,
+// in units of the equatorial radius of its ellipsoid.
+func (o Observer) ParallaxFactors() (rhoSinPhi, rhoCosPhi float64) {
+	return o.ellipsoid().ParallaxFactors(o.Lat, o.Elevation)
+}
+
+// ellipsoid returns the reference ellipsoid of the observer, WGS84 if unset.
+func (o Observer) ellipsoid() earth.Ellipsoid {
+	if o.Ellipsoid == (earth.Ellipsoid{}) {
+		return earth.WGS84
+	}
+	return o.Ellipsoid
+}
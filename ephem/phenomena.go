@@ -0,0 +1,171 @@
+package ephem
+
+import (
+	"cmp"
+	"math"
+	"slices"
+
+	"github.com/ilbagatto/vsop87-go/internal/search"
+)
+
+// PhenomenonType is the kind of a planetary phenomenon.
+type PhenomenonType int
+
+const (
+	// Conjunction of an outer planet with the Sun.
+	Conjunction PhenomenonType = iota
+	// InferiorConjunction: Mercury or Venus passes between the Earth and the Sun.
+	InferiorConjunction
+	// SuperiorConjunction: Mercury or Venus passes behind the Sun.
+	SuperiorConjunction
+	// Opposition of an outer planet to the Sun.
+	Opposition
+	// EasternQuadrature: an outer planet is 90° east of the Sun.
+	EasternQuadrature
+	// WesternQuadrature: an outer planet is 90° west of the Sun.
+	WesternQuadrature
+	// GreatestEasternElongation of Mercury or Venus (evening visibility).
+	GreatestEasternElongation
+	// GreatestWesternElongation of Mercury or Venus (morning visibility).
+	GreatestWesternElongation
+)
+
+var phenomenonNames = [...]string{
+	"conjunction",
+	"inferior conjunction",
+	"superior conjunction",
+	"opposition",
+	"eastern quadrature",
+	"western quadrature",
+	"greatest eastern elongation",
+	"greatest western elongation",
+}
+
+func (t PhenomenonType) String() string {
+	if t < 0 || int(t) >= len(phenomenonNames) {
+		return "unknown"
+	}
+	return phenomenonNames[t]
+}
+
+// Phenomenon is a configuration of a planet relative to the Sun.
+type Phenomenon struct {
+	JD         float64 // Julian Day (TT)
+	Type       PhenomenonType
+	Elongation float64 // angular distance from the Sun, radians
+}
+
+// elongationStep is the step of the numerical derivative of the elongation,
+// in days.
+const elongationStep = 1.0 / 24
+
+// Phenomena returns the conjunctions, oppositions, quadratures and greatest
+// elongations of a planet between start and end (Julian Days, TT), in
+// chronological order. Conjunctions, oppositions and quadratures are
+// reckoned in longitude (Meeus, ch. 36). The Sun and the Moon yield an
+// *UnsupportedBodyError.
+func Phenomena(body Body, start, end float64) ([]Phenomenon, error) {
+	return defaultEphemeris.Phenomena(body, start, end)
+}
+
+// Phenomena returns the phenomena of a planet between start and end, using
+// the reduction and frame of the ephemeris.
+func (e *Ephemeris) Phenomena(body Body, start, end float64) ([]Phenomenon, error) {
+	if body == Sun || body == Moon {
+		return nil, &UnsupportedBodyError{Body: body, Op: "phenomena"}
+	}
+	if _, err := e.computer(body); err != nil {
+		return nil, err
+	}
+	inner := body == Mercury || body == Venus
+	angles := []float64{0, math.Pi / 2, math.Pi}
+	if inner {
+		angles = angles[:1]
+	}
+	hits, err := aspects(e.bodyLongitude(body), e.bodyLongitude(Sun), start, end, crossingStep(body), angles)
+	if err != nil {
+		return nil, err
+	}
+
+	var res []Phenomenon
+	for _, h := range hits {
+		planet, sun, err := e.planetAndSun(body, h.JD)
+		if err != nil {
+			return nil, err
+		}
+		p := Phenomenon{JD: h.JD, Elongation: elongation(planet, sun)}
+		switch {
+		case h.Angle == 0 && !inner:
+			p.Type = Conjunction
+		case h.Angle == 0 && planet.Radius < sun.Radius:
+			p.Type = InferiorConjunction
+		case h.Angle == 0:
+			p.Type = SuperiorConjunction
+		case h.Angle == math.Pi:
+			p.Type = Opposition
+		case h.Diff < math.Pi:
+			p.Type = EasternQuadrature
+		default:
+			p.Type = WesternQuadrature
+		}
+		res = append(res, p)
+	}
+
+	if inner {
+		greatest, err := e.greatestElongations(body, start, end)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, greatest...)
+		slices.SortFunc(res, func(a, b Phenomenon) int {
+			return cmp.Compare(a.JD, b.JD)
+		})
+	}
+	return res, nil
+}
+
+// greatestElongations returns the maxima of the elongation of Mercury or
+// Venus between start and end.
+func (e *Ephemeris) greatestElongations(body Body, start, end float64) ([]Phenomenon, error) {
+	elong := func(jd float64) (float64, error) {
+		planet, sun, err := e.planetAndSun(body, jd)
+		return elongation(planet, sun), err
+	}
+	roots, err := search.Roots(search.Derivative(elong, elongationStep), start, end, stationStep(body), crossingTol, 0)
+	if err != nil {
+		return nil, err
+	}
+	var res []Phenomenon
+	for _, r := range roots {
+		if r.Rising {
+			continue // a minimum, near conjunction
+		}
+		planet, sun, err := e.planetAndSun(body, r.JD)
+		if err != nil {
+			return nil, err
+		}
+		p := Phenomenon{JD: r.JD, Type: GreatestWesternElongation, Elongation: elongation(planet, sun)}
+		if math.Remainder(planet.Lambda-sun.Lambda, Pi2) > 0 {
+			p.Type = GreatestEasternElongation
+		}
+		res = append(res, p)
+	}
+	return res, nil
+}
+
+// planetAndSun returns the positions of a planet and the Sun at jd.
+func (e *Ephemeris) planetAndSun(body Body, jd float64) (EclCoord, EclCoord, error) {
+	in := e.NewInstant(jd)
+	planet, err := e.EclipticPositionAt(body, in)
+	if err != nil {
+		return EclCoord{}, EclCoord{}, err
+	}
+	sun, err := e.EclipticPositionAt(Sun, in)
+	return planet, sun, err
+}
+
+// elongation returns the angular distance between two positions, radians.
+func elongation(a, b EclCoord) float64 {
+	c := math.Sin(a.Beta)*math.Sin(b.Beta) + math.Cos(a.Beta)*math.Cos(b.Beta)*math.Cos(a.Lambda-b.Lambda)
+	return math.Acos(math.Max(-1, math.Min(1, c)))
+}
@@ -0,0 +1,55 @@
+package ephem
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/ilbagatto/vsop87-go/mathutils"
+)
+
+func TestPhenomenaMercury(t *testing.T) {
+	exp := []struct {
+		jd    float64
+		typ   PhenomenonType
+		elong float64 // degrees
+	}{
+		{2460322.11, GreatestWesternElongation, 23.5}, // 2024 Jan 12
+		{2460368.86, SuperiorConjunction, 1.8},        // Feb 28
+		{2460394.44, GreatestEasternElongation, 18.7}, // Mar 24
+		{2460412.46, InferiorConjunction, 2.2},        // Apr 11
+	}
+	got, err := Phenomena(Mercury, 2460310.5, 2460420.5)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != len(exp) {
+		t.Fatalf("Expected %d phenomena. Got: %v", len(exp), got)
+	}
+	for i, p := range got {
+		if math.Abs(p.JD-exp[i].jd) > 0.01 || p.Type != exp[i].typ ||
+			!mathutils.AlmostEqual(mathutils.Degrees(p.Elongation), exp[i].elong, 0.05) {
+			t.Errorf("Phenomenon #%d should be %s at %.2f (%.1f°). Got: %s at %.4f (%.2f°)",
+				i, exp[i].typ, exp[i].jd, exp[i].elong, p.Type, p.JD, mathutils.Degrees(p.Elongation))
+		}
+	}
+}
+
+func TestPhenomenaMars(t *testing.T) {
+	// opposition 2025 January 16, about 2:35 UT
+	got, err := Phenomena(Mars, 2460600.5, 2460700.5)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != 1 || got[0].Type != Opposition || math.Abs(got[0].JD-2460691.61) > 0.01 {
+		t.Errorf("Expected opposition at 2460691.61. Got: %v", got)
+	}
+}
+
+func TestPhenomenaUnsupported(t *testing.T) {
+	_, err := Phenomena(Moon, 2451545.0, 2451910.0)
+	var ube *UnsupportedBodyError
+	if !errors.As(err, &ube) {
+		t.Errorf("Expected UnsupportedBodyError. Got: %v", err)
+	}
+}
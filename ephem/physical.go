@@ -0,0 +1,82 @@
+package ephem
+
+import "github.com/ilbagatto/vsop87-go/internal/physical"
+
+// PhysicalEphemeris describes the aspect of the disk of Mars or Jupiter as
+// seen from the Earth (Meeus, ch. 42–43). All angles are in radians.
+type PhysicalEphemeris struct {
+	EarthDeclination  float64 // planetocentric declination of the Earth D_E
+	SunDeclination    float64 // planetocentric declination of the Sun D_S
+	PositionAngle     float64 // position angle of the north pole P, from the north through the east
+	CentralMeridian   float64 // longitude of the central meridian; System I for Jupiter
+	CentralMeridianII float64 // longitude of the central meridian in System II; Jupiter only
+	Illuminated       float64 // illuminated fraction of the disk k
+	Defect            float64 // greatest defect of illumination q = (1 − k)·d
+}
+
+// PlanetPhysical returns the physical ephemeris of Mars or Jupiter at jdTT.
+// The central meridians of Jupiter are corrected for phase. Other bodies
+// and custom backends yield an *UnsupportedBodyError.
+func PlanetPhysical(body Body, jdTT float64) (PhysicalEphemeris, error) {
+	return defaultEphemeris.PlanetPhysical(body, jdTT)
+}
+
+// PlanetPhysical returns the physical ephemeris of Mars or Jupiter at jdTT,
+// using the ephemeris.
+func (e *Ephemeris) PlanetPhysical(body Body, jdTT float64) (PhysicalEphemeris, error) {
+	return e.PlanetPhysicalAt(body, e.NewInstant(jdTT))
+}
+
+// PlanetPhysicalAt is like PlanetPhysical but takes the shared quantities
+// from the instant.
+func PlanetPhysicalAt(body Body, in *Instant) (PhysicalEphemeris, error) {
+	return defaultEphemeris.PlanetPhysicalAt(body, in)
+}
+
+// PlanetPhysicalAt is like PlanetPhysical but takes the shared quantities
+// from the instant.
+func (e *Ephemeris) PlanetPhysicalAt(body Body, in *Instant) (PhysicalEphemeris, error) {
+	var disk func(physical.Geometry) physical.Disk
+	switch body {
+	case Mars:
+		disk = physical.MarsDisk
+	case Jupiter:
+		disk = physical.JupiterDisk
+	default:
+		return PhysicalEphemeris{}, &UnsupportedBodyError{Body: body, Op: "physical ephemeris"}
+	}
+	comp, err := e.computer(body)
+	if err != nil {
+		return PhysicalEphemeris{}, err
+	}
+	lt, ok := comp.(lightTimer)
+	if !ok {
+		return PhysicalEphemeris{}, &UnsupportedBodyError{Body: body, Op: "physical ephemeris"}
+	}
+	helio, delta := lt.lightTimeLBR(in)
+	earth := in.EarthLBR()
+	dpsi, deps := in.Nutation()
+	d := disk(physical.Geometry{
+		JD: in.JD(),
+		L:  helio.Phi, B: helio.Theta, R: helio.R,
+		L0: earth.Phi, B0: earth.Theta, R0: earth.R,
+		Eps0:     in.MeanObliquity(),
+		DeltaPsi: dpsi,
+		DeltaEps: deps,
+	})
+
+	k := physical.Illuminated(physical.PhaseAngle(helio.R, delta, earth.R))
+	sd, err := e.semidiameter(body, in, delta)
+	if err != nil {
+		return PhysicalEphemeris{}, err
+	}
+	return PhysicalEphemeris{
+		EarthDeclination:  d.DE,
+		SunDeclination:    d.DS,
+		PositionAngle:     d.P,
+		CentralMeridian:   d.CM1,
+		CentralMeridianII: d.CM2,
+		Illuminated:       k,
+		Defect:            (1 - k) * sd.Diameter(),
+	}, nil
+}
@@ -0,0 +1,51 @@
+package ephem
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ilbagatto/vsop87-go/mathutils"
+)
+
+func TestPlanetPhysical(t *testing.T) {
+	cases := []struct {
+		body      Body
+		jd        float64 // 0h UT, ΔT = 59 s
+		de, ds, p float64 // degrees
+		cm1, cm2  float64 // degrees
+		defect    float64 // arcseconds
+		describe  string
+	}{
+		// Meeus, example 42.a
+		{Mars, 2448935.500683, 12.44, -2.76, 347.64, 111.55, 0, 1.06, "Mars, 1992 November 9"},
+		// Meeus, example 43.b
+		{Jupiter, 2448972.500683, -2.48, -2.20, 24.80, 268.06, 72.74, 0.26, "Jupiter, 1992 December 16"},
+	}
+	for _, c := range cases {
+		got, err := PlanetPhysical(c.body, c.jd)
+		if err != nil {
+			t.Fatal(err)
+		}
+		check := func(name string, got, exp, tol float64) {
+			if !mathutils.AlmostEqual(got, exp, tol) {
+				t.Errorf("%s: %s should be %.2f. Got: %.3f", c.describe, name, exp, got)
+			}
+		}
+		check("D_E", mathutils.Degrees(got.EarthDeclination), c.de, 0.01)
+		check("D_S", mathutils.Degrees(got.SunDeclination), c.ds, 0.01)
+		check("P", mathutils.Degrees(got.PositionAngle), c.p, 0.01)
+		check("ω", mathutils.Degrees(got.CentralMeridian), c.cm1, 0.02)
+		check("ω2", mathutils.Degrees(got.CentralMeridianII), c.cm2, 0.02)
+		check("q", arcsec(got.Defect), c.defect, 0.01)
+	}
+}
+
+func TestPlanetPhysicalUnsupported(t *testing.T) {
+	for _, body := range []Body{Sun, Moon, Saturn} {
+		_, err := PlanetPhysical(body, 2448935.5)
+		var ue *UnsupportedBodyError
+		if !errors.As(err, &ue) || ue.Body != body {
+			t.Errorf("%v: expected UnsupportedBodyError. Got: %v", body, err)
+		}
+	}
+}
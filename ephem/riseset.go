@@ -0,0 +1,241 @@
+package ephem
+
+import (
+	"math"
+
+	"github.com/ilbagatto/vsop87-go/coco"
+	"github.com/ilbagatto/vsop87-go/internal/moon"
+	"github.com/ilbagatto/vsop87-go/mathutils"
+	"github.com/ilbagatto/vsop87-go/timeutils"
+	"github.com/ilbagatto/vsop87-go/utils"
+)
+
+// Standard altitudes of the centre of a body at the moment of rising or
+// setting (Meeus, ch. 15), in radians.
+var (
+	// SunAltitude accounts for refraction and the solar semidiameter (−0°50′).
+	SunAltitude = mathutils.Radians(-0.8333)
+	// PlanetAltitude accounts for refraction only (−0°34′).
+	PlanetAltitude = mathutils.Radians(-0.5667)
+)
+
+// maxRiseSetIter limits the number of refinement steps for a single event.
+const maxRiseSetIter = 20
+
+// riseSetTol is the convergence tolerance, in days (about 0.01 s).
+const riseSetTol = 1e-7
+
+// RiseSet holds the times of rising, meridian transit and setting of a body
+// for one UT date. All times are Julian Days (UT).
+//
+// A zero time means that the event does not occur on that date: either the
+// body stays above (Circumpolar) or below (NeverRises) the horizon, or, for
+// the Moon, the event falls on the neighbouring date.
+type RiseSet struct {
+	Rise    float64
+	Transit float64
+	Set     float64
+
+	Circumpolar bool // the body does not set
+	NeverRises  bool // the body does not rise
+}
+
+// StandardAltitude returns the standard altitude h0 (radians) of a body for
+// rise/set computations. dist is the geocentric distance in AU; it is used
+// only for the Moon, whose h0 = 0.7275·π − 0°34′ depends on the horizontal
+// parallax π.
+func StandardAltitude(body Body, dist float64) float64 {
+	switch body {
+	case Sun:
+		return SunAltitude
+	case Moon:
+		return 0.7275*moon.Parallax(utils.AuToKm(dist)) + PlanetAltitude
+	default:
+		return PlanetAltitude
+	}
+}
+
+// RiseTransitSet computes the times of rising, transit and setting of a body
+// for the UT date containing jd, using the standard altitude of the body.
+//
+//	lat : geographical latitude, radians (positive north)
+//	lng : geographical longitude, radians (negative westwards)
+func RiseTransitSet(body Body, jd, lat, lng float64) (RiseSet, error) {
+	return defaultEphemeris.RiseTransitSet(body, jd, lat, lng)
+}
+
+// RiseTransitSet computes the times of rising, transit and setting of a body
+// for the UT date containing jd, using the standard altitude of the body.
+func (e *Ephemeris) RiseTransitSet(body Body, jd, lat, lng float64) (RiseSet, error) {
+	return e.riseTransitSet(body, jd, lat, lng, func(dist float64) float64 {
+		return StandardAltitude(body, dist)
+	})
+}
+
+// RiseTransitSetAltitude is like RiseTransitSet but uses the given altitude
+// h0 (radians) instead of the standard one, e.g. −6° for civil twilight.
+func RiseTransitSetAltitude(body Body, jd, lat, lng, h0 float64) (RiseSet, error) {
+	return defaultEphemeris.RiseTransitSetAltitude(body, jd, lat, lng, h0)
+}
+
+// RiseTransitSetAltitude is like RiseTransitSet but uses the given altitude
+// h0 (radians) instead of the standard one.
+func (e *Ephemeris) RiseTransitSetAltitude(body Body, jd, lat, lng, h0 float64) (RiseSet, error) {
+	return e.riseTransitSet(body, jd, lat, lng, func(float64) float64 {
+		return h0
+	})
+}
+
+// riseSetCalc holds the inputs shared by the refinement of all three events.
+type riseSetCalc struct {
+	eph      *Ephemeris
+	body     Body
+	jd0      float64 // 0h UT of the date
+	lat, lng float64
+	altitude func(dist float64) float64
+}
+
+// riseTransitSet implements Meeus ch. 15, but instead of interpolating
+// between three tabulated positions, it recomputes the position of the body
+// at every approximation, so that fast-moving bodies are handled correctly.
+func (e *Ephemeris) riseTransitSet(body Body, jd, lat, lng float64, altitude func(float64) float64) (RiseSet, error) {
+	c := riseSetCalc{
+		eph:      e,
+		body:     body,
+		jd0:      timeutils.JulianMidnight(jd),
+		lat:      lat,
+		lng:      lng,
+		altitude: altitude,
+	}
+
+	// approximate transit from the position at 0h UT
+	ra, _, _, gst, err := c.position(c.jd0)
+	if err != nil {
+		return RiseSet{}, err
+	}
+	m0 := mathutils.ToRange((ra-lng-gst)/Pi2, 1)
+
+	var res RiseSet
+	mt, ok, err := c.refineInDay(m0, c.transitStep)
+	if err != nil {
+		return RiseSet{}, err
+	}
+	if ok {
+		res.Transit = c.jd0 + mt
+	} else {
+		// take the transit closest to the date to estimate rise and set
+		mt = m0
+	}
+
+	// hour angle of rising/setting at the transit
+	_, dec, dist, _, err := c.position(c.jd0 + mt)
+	if err != nil {
+		return RiseSet{}, err
+	}
+	cosH0 := (math.Sin(altitude(dist)) - math.Sin(lat)*math.Sin(dec)) / (math.Cos(lat) * math.Cos(dec))
+	switch {
+	case cosH0 < -1:
+		res.Circumpolar = true
+		return res, nil
+	case cosH0 > 1:
+		res.NeverRises = true
+		return res, nil
+	}
+	dm := math.Acos(cosH0) / Pi2
+
+	for _, ev := range []struct {
+		m   float64
+		dst *float64
+	}{
+		{mt - dm, &res.Rise},
+		{mt + dm, &res.Set},
+	} {
+		m, ok, err := c.refineInDay(mathutils.ToRange(ev.m, 1), c.horizonStep)
+		if err != nil {
+			return RiseSet{}, err
+		}
+		if ok {
+			*ev.dst = c.jd0 + m
+		}
+	}
+	return res, nil
+}
+
+// position returns apparent right ascension, declination (radians),
+// distance (AU) of the body and Greenwich apparent sidereal time (radians)
+// at the given JD(UT).
+func (c riseSetCalc) position(jdUT float64) (ra, dec, dist, gst float64, err error) {
+	in := c.eph.NewInstantUT(jdUT)
+	equ, err := c.eph.equatorialAt(c.body, in, Apparent, FrameOfDate)
+	if err != nil {
+		return 0, 0, 0, 0, err
+	}
+	return equ.Alpha, equ.Delta, equ.Radius, in.SiderealTime(), nil
+}
+
+// transitStep returns the correction Δm (days) which brings the local hour
+// angle of the body to zero.
+func (c riseSetCalc) transitStep(m float64) (float64, error) {
+	ra, _, _, gst, err := c.position(c.jd0 + m)
+	if err != nil {
+		return 0, err
+	}
+	h := mathutils.AngNormPi(gst + c.lng - ra)
+	return -h / Pi2, nil
+}
+
+// horizonStep returns the correction Δm (days) which brings the altitude of
+// the body to h0.
+func (c riseSetCalc) horizonStep(m float64) (float64, error) {
+	ra, dec, dist, gst, err := c.position(c.jd0 + m)
+	if err != nil {
+		return 0, err
+	}
+	h := gst + c.lng - ra
+	_, alt := coco.Equ2Hor(ra, dec, h, c.lat)
+	return (alt - c.altitude(dist)) / (Pi2 * math.Cos(dec) * math.Cos(c.lat) * math.Sin(h)), nil
+}
+
+// refineInDay iterates step starting from m (fraction of the day) and reports
+// whether the event converged within the date. If it converged on the
+// neighbouring date, the search is repeated once from the other end.
+func (c riseSetCalc) refineInDay(m float64, step func(float64) (float64, error)) (float64, bool, error) {
+	for range 2 {
+		r, converged, err := refine(m, step)
+		if err != nil {
+			return 0, false, err
+		}
+		if !converged {
+			return 0, false, nil
+		}
+		switch {
+		case r < 0:
+			m = m + 1
+		case r >= 1:
+			m = m - 1
+		default:
+			return r, true, nil
+		}
+	}
+	return 0, false, nil
+}
+
+// refine applies step until the correction drops below riseSetTol.
+func refine(m float64, step func(float64) (float64, error)) (float64, bool, error) {
+	for range maxRiseSetIter {
+		dm, err := step(m)
+		if err != nil {
+			return 0, false, err
+		}
+		if math.IsNaN(dm) || math.IsInf(dm, 0) {
+			return 0, false, nil
+		}
+		// keep the Newton step bounded near grazing events
+		dm = math.Max(-0.25, math.Min(0.25, dm))
+		m += dm
+		if math.Abs(dm) < riseSetTol {
+			return m, true, nil
+		}
+	}
+	return m, false, nil
+}
@@ -0,0 +1,141 @@
+package ephem
+
+import (
+	"math"
+	"testing"
+
+	"github.com/ilbagatto/vsop87-go/earth"
+	"github.com/ilbagatto/vsop87-go/mathutils"
+)
+
+func TestRiseTransitSetVenus(t *testing.T) {
+	// Meeus, p.103: Venus at Boston, 1988 March 20
+	const (
+		jd        = 2447240.5
+		threshold = 1.0 / 1440 // 1 minute
+	)
+	lat := mathutils.Radians(42.3333)
+	lng := mathutils.Radians(-71.0833)
+
+	got, err := RiseTransitSetAltitude(Venus, jd, lat, lng, PlanetAltitude)
+	if err != nil {
+		t.Fatalf("RiseTransitSet: %v", err)
+	}
+	if got.Circumpolar || got.NeverRises {
+		t.Fatalf("unexpected flags: %+v", got)
+	}
+
+	tests := []struct {
+		name string
+		exp  float64
+		got  float64
+	}{
+		{"Rise", jd + 0.51766, got.Rise},
+		{"Transit", jd + 0.81980, got.Transit},
+		{"Set", jd + 0.12130, got.Set},
+	}
+	for _, tc := range tests {
+		if !mathutils.AlmostEqual(tc.got, tc.exp, threshold) {
+			t.Errorf("%s should be %.5f. Got: %.5f", tc.name, tc.exp, tc.got)
+		}
+	}
+}
+
+func TestRiseTransitSetPolar(t *testing.T) {
+	const jd = 2460482.5 // 2024 June 21
+	lng := mathutils.Radians(15.0)
+
+	north, err := RiseTransitSet(Sun, jd, mathutils.Radians(80), lng)
+	if err != nil {
+		t.Fatalf("RiseTransitSet: %v", err)
+	}
+	if !north.Circumpolar || north.Rise != 0 || north.Set != 0 {
+		t.Errorf("Sun should be circumpolar at 80°N. Got: %+v", north)
+	}
+	if north.Transit == 0 {
+		t.Errorf("Sun should transit at 80°N")
+	}
+
+	south, err := RiseTransitSet(Sun, jd, mathutils.Radians(-80), lng)
+	if err != nil {
+		t.Fatalf("RiseTransitSet: %v", err)
+	}
+	if !south.NeverRises {
+		t.Errorf("Sun should never rise at 80°S. Got: %+v", south)
+	}
+}
+
+func TestRiseTransitSetMoon(t *testing.T) {
+	// London, 2024 June 21–23, around the Full Moon of June 22. Each event is
+	// checked against its definition, from the topocentric position of the
+	// Moon: at rising and setting the upper limb is 34′ below the airless
+	// horizon (the standard refraction), at transit the Moon is due south.
+	// An error of 1 minute of time shifts the altitude by about 9′ and the
+	// azimuth at transit by about 0.4°.
+	const jd = 2460482.5
+	lat := mathutils.Radians(51.5)
+	obs := Observer{Lat: lat}
+	airless := HorizontalOptions{Atmosphere: &earth.Atmosphere{}}
+	horizon := mathutils.Radians(-34.0 / 60)
+
+	limb := func(ut float64) float64 {
+		hor, err := HorizontalPosition(Moon, ut, obs, airless)
+		if err != nil {
+			t.Fatal(err)
+		}
+		sd, err := TopocentricSemidiameter(Moon, NewInstantUT(ut).JD(), obs)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return hor.Altitude + sd.Equatorial
+	}
+
+	var prev RiseSet
+	for i := range 3 {
+		day := jd + float64(i)
+		got, err := RiseTransitSet(Moon, day, lat, 0)
+		if err != nil {
+			t.Fatalf("RiseTransitSet: %v", err)
+		}
+		if got.Rise == 0 || got.Set == 0 {
+			t.Fatalf("day %d: the Moon should rise and set. Got: %+v", i, got)
+		}
+		for _, ev := range []struct {
+			name string
+			jd   float64
+			up   bool
+		}{{"Rise", got.Rise, true}, {"Set", got.Set, false}} {
+			if ev.jd < day || ev.jd >= day+1 {
+				t.Errorf("day %d: %s %.5f is outside the date", i, ev.name, ev.jd)
+			}
+			if d := mathutils.Degrees(limb(ev.jd)-horizon) * 60; math.Abs(d) > 0.5 {
+				t.Errorf("day %d: at %s the upper limb should be at -34′. Off by %.2f′", i, ev.name, d)
+			}
+			if rising := limb(ev.jd+1.0/1440) > limb(ev.jd); rising != ev.up {
+				t.Errorf("day %d: wrong direction of motion at %s", i, ev.name)
+			}
+		}
+		// the lunar day exceeds 24 h: no transit on June 22
+		if (got.Transit == 0) != (i == 1) {
+			t.Errorf("day %d: unexpected transit %.5f", i, got.Transit)
+		}
+		if got.Transit != 0 {
+			hor, err := HorizontalPosition(Moon, got.Transit, obs, airless)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if d := mathutils.Degrees(hor.Azimuth) - 180; math.Abs(d) > 0.05 {
+				t.Errorf("day %d: at transit the Moon should be due south. Off by %.3f°", i, d)
+			}
+		}
+		// the Moon rises and sets about 50 minutes later each day
+		if i > 0 {
+			for _, d := range []float64{got.Rise - prev.Rise, got.Set - prev.Set} {
+				if late := (d - 1) * 1440; late < 20 || late > 90 {
+					t.Errorf("day %d: events should be 20–90 min later than the day before. Got: %.0f", i, late)
+				}
+			}
+		}
+		prev = got
+	}
+}
@@ -0,0 +1,114 @@
+package ephem
+
+import (
+	"math"
+
+	"github.com/ilbagatto/vsop87-go/timeutils"
+)
+
+// solarTermNames are the names of the 24 solar terms (jiéqì), from the
+// March equinox, at 0° of apparent solar longitude, in steps of 15°.
+var solarTermNames = [24]string{
+	"Chunfen", "Qingming", "Guyu", "Lixia", "Xiaoman", "Mangzhong",
+	"Xiazhi", "Xiaoshu", "Dashu", "Liqiu", "Chushu", "Bailu",
+	"Qiufen", "Hanlu", "Shuangjiang", "Lidong", "Xiaoxue", "Daxue",
+	"Dongzhi", "Xiaohan", "Dahan", "Lichun", "Yushui", "Jingzhe",
+}
+
+// SolarTerm is a moment when the apparent longitude of the Sun is a
+// multiple of 15°. Terms 0, 6, 12 and 18 are the March equinox, June
+// solstice, September equinox and December solstice.
+type SolarTerm struct {
+	JD    float64 // Julian Day (TT)
+	UT    float64 // Julian Day (UT)
+	Index int     // longitude of the Sun / 15°, 0..23
+}
+
+// Lambda returns the longitude of the Sun at the term, radians.
+func (t SolarTerm) Lambda() float64 {
+	return float64(t.Index) * math.Pi / 12
+}
+
+// Name returns the Chinese name of the term, e.g. "Chunfen" for the March
+// equinox.
+func (t SolarTerm) Name() string {
+	return solarTermNames[t.Index]
+}
+
+// Season is the interval between an equinox and the next solstice, or a
+// solstice and the next equinox. The season is named after the northern
+// hemisphere: it is spring when Start.Index is 0.
+type Season struct {
+	Start, End SolarTerm
+	Length     float64 // days
+}
+
+// SolarTerms returns the 24 solar terms of each year from startYear to
+// endYear inclusive, in chronological order.
+func SolarTerms(startYear, endYear int) ([]SolarTerm, error) {
+	return defaultEphemeris.SolarTerms(startYear, endYear)
+}
+
+// SolarTerms returns the solar terms of the years from startYear to endYear,
+// using the ephemeris. The reduction should be apparent and the frame of date
+// for the terms to have their usual meaning.
+func (e *Ephemeris) SolarTerms(startYear, endYear int) ([]SolarTerm, error) {
+	return e.solarTerms(yearStart(startYear), yearStart(endYear+1), 1)
+}
+
+// Seasons returns the equinoxes and solstices of the years from startYear to
+// endYear, with the lengths of the seasons they begin. The last season ends
+// in endYear + 1.
+func Seasons(startYear, endYear int) ([]Season, error) {
+	return defaultEphemeris.Seasons(startYear, endYear)
+}
+
+// Seasons returns the seasons beginning in the years from startYear to
+// endYear, using the ephemeris.
+func (e *Ephemeris) Seasons(startYear, endYear int) ([]Season, error) {
+	end := yearStart(endYear + 1)
+	// the March equinox of the following year ends the last season
+	terms, err := e.solarTerms(yearStart(startYear), end+100, 6)
+	if err != nil {
+		return nil, err
+	}
+	var seasons []Season
+	for i := 1; i < len(terms); i++ {
+		if terms[i-1].JD >= end {
+			break
+		}
+		seasons = append(seasons, Season{
+			Start:  terms[i-1],
+			End:    terms[i],
+			Length: terms[i].JD - terms[i-1].JD,
+		})
+	}
+	return seasons, nil
+}
+
+// solarTerms returns the terms between start and end (TT) whose index is a
+// multiple of every.
+func (e *Ephemeris) solarTerms(start, end float64, every int) ([]SolarTerm, error) {
+	var targets []float64
+	for i := 0; i < 24; i += every {
+		targets = append(targets, float64(i)*math.Pi/12)
+	}
+	crossings, err := e.Crossings(Sun, start, end, targets...)
+	if err != nil {
+		return nil, err
+	}
+	terms := make([]SolarTerm, len(crossings))
+	for i, c := range crossings {
+		terms[i] = SolarTerm{
+			JD:    c.JD,
+			UT:    c.JD - timeutils.DeltaT(c.JD)*timeutils.DaysPerSec,
+			Index: int(math.Round(c.Lambda/(math.Pi/12))) % 24,
+		}
+	}
+	return terms, nil
+}
+
+// yearStart returns the Julian Day of January 1.0 of a year.
+func yearStart(year int) float64 {
+	return timeutils.JulianDateZero(year) + 1
+}
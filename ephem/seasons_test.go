@@ -0,0 +1,53 @@
+package ephem
+
+import (
+	"math"
+	"testing"
+)
+
+func TestSolarTerms(t *testing.T) {
+	got, err := SolarTerms(2024, 2024)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != 24 {
+		t.Fatalf("Expected 24 terms. Got: %d", len(got))
+	}
+	// the year begins with Xiaohan, about January 6
+	for i, term := range got {
+		if exp := (19 + i) % 24; term.Index != exp {
+			t.Errorf("Term #%d should be %d. Got: %d", i, exp, term.Index)
+		}
+	}
+	// March equinox: 2024 March 20, 3:06 UT
+	eq := got[5]
+	if eq.Name() != "Chunfen" || eq.Lambda() != 0 || math.Abs(eq.UT-2460389.6292) > 1.0/1440 {
+		t.Errorf("March equinox should be at 2460389.6292 UT. Got: %s at %.4f", eq.Name(), eq.UT)
+	}
+}
+
+func TestSeasons(t *testing.T) {
+	// Meeus, example 27.a: June solstice 1962 at JDE 2437837.39245
+	const solstice = 2437837.39245
+	got, err := Seasons(1962, 1962)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != 4 {
+		t.Fatalf("Expected 4 seasons. Got: %d", len(got))
+	}
+	if got[1].Start.Index != 6 || math.Abs(got[1].Start.JD-solstice) > 1.0/1440 {
+		t.Errorf("Summer should begin at %.5f. Got: %+v", solstice, got[1].Start)
+	}
+	for i, s := range got {
+		if s.Start.Index != 6*i || s.End.Index != 6*((i+1)%4) {
+			t.Errorf("Season #%d has wrong bounds: %+v", i, s)
+		}
+		if s.Length < 88 || s.Length > 94 || s.Length != s.End.JD-s.Start.JD {
+			t.Errorf("Season #%d length %.3f is out of range", i, s.Length)
+		}
+	}
+	if got[0].End != got[1].Start {
+		t.Errorf("Seasons should be contiguous")
+	}
+}
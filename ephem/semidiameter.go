@@ -0,0 +1,142 @@
+package ephem
+
+import (
+	"math"
+
+	"github.com/ilbagatto/vsop87-go/earth"
+	"github.com/ilbagatto/vsop87-go/utils"
+)
+
+// Semidiameter holds the apparent equatorial and polar semidiameters of a
+// body, radians. They are equal for bodies treated as spheres.
+type Semidiameter struct {
+	Equatorial float64
+	Polar      float64
+}
+
+// Diameter returns the apparent equatorial diameter, radians.
+func (s Semidiameter) Diameter() float64 {
+	return 2 * s.Equatorial
+}
+
+// Radii of the Sun and the Moon, in equatorial radii of the Earth
+// (earth.IAU1976.A). Semidiameters, parallaxes and eclipses all use them.
+const (
+	// sunRadius gives 959.63″ at 1 AU (Meeus, ch. 55).
+	sunRadius = 109.1222
+	// moonRadius is the mean radius of the Moon (IAU, k = 0.2725076).
+	moonRadius = 0.2725076
+	// moonRadiusValleys is the radius to the valleys of the lunar limb,
+	// which bound the umbral phases of solar eclipses (NASA canon).
+	moonRadiusValleys = 0.2722810
+)
+
+// angularRadius returns the semidiameter (radians) of a sphere of radius r,
+// in Earth equatorial radii, at distance dist (AU).
+func angularRadius(r, dist float64) float64 {
+	return math.Asin(r * earth.IAU1976.A / utils.AuToKm(dist))
+}
+
+// semidiameters0 holds the equatorial and polar semidiameters of the
+// planets at 1 AU, arcseconds (Meeus, ch. 55). Venus is measured to the top
+// of the clouds.
+var semidiameters0 = map[Body]Semidiameter{
+	Mercury: {3.36, 3.36},
+	Venus:   {8.41, 8.41},
+	Mars:    {4.68, 4.68},
+	Jupiter: {98.44, 92.06},
+	Saturn:  {82.73, 77.63},
+	Uranus:  {35.02, 35.02},
+	Neptune: {33.50, 33.50},
+	Pluto:   {2.07, 2.07},
+}
+
+// ApparentSemidiameter returns the geocentric apparent semidiameter of a
+// body at jdTT, from its distance in EclCoord.Radius. The polar
+// semidiameter of Saturn is that of the apparent disk, foreshortened by the
+// tilt of the rings; for Jupiter the true polar value is returned. Saturn
+// needs the built-in series to find the tilt, so with a custom backend it
+// yields an *UnsupportedBodyError.
+func ApparentSemidiameter(body Body, jdTT float64) (Semidiameter, error) {
+	return defaultEphemeris.ApparentSemidiameter(body, jdTT)
+}
+
+// ApparentSemidiameter returns the geocentric apparent semidiameter of a
+// body at jdTT, using the ephemeris.
+func (e *Ephemeris) ApparentSemidiameter(body Body, jdTT float64) (Semidiameter, error) {
+	return e.ApparentSemidiameterAt(body, e.NewInstant(jdTT))
+}
+
+// ApparentSemidiameterAt is like ApparentSemidiameter but takes the shared
+// quantities from the instant.
+func ApparentSemidiameterAt(body Body, in *Instant) (Semidiameter, error) {
+	return defaultEphemeris.ApparentSemidiameterAt(body, in)
+}
+
+// ApparentSemidiameterAt is like ApparentSemidiameter but takes the shared
+// quantities from the instant.
+func (e *Ephemeris) ApparentSemidiameterAt(body Body, in *Instant) (Semidiameter, error) {
+	pos, err := e.EclipticPositionAt(body, in)
+	if err != nil {
+		return Semidiameter{}, err
+	}
+	return e.semidiameter(body, in, pos.Radius)
+}
+
+// TopocentricSemidiameter returns the apparent semidiameter of a body as
+// seen by the observer at jdTT. It differs from the geocentric value mainly
+// for the Moon, whose disk grows by up to 0.3″ per degree of altitude.
+func TopocentricSemidiameter(body Body, jdTT float64, obs Observer) (Semidiameter, error) {
+	return defaultEphemeris.TopocentricSemidiameter(body, jdTT, obs)
+}
+
+// TopocentricSemidiameter returns the apparent semidiameter of a body as
+// seen by the observer at jdTT.
+func (e *Ephemeris) TopocentricSemidiameter(body Body, jdTT float64, obs Observer) (Semidiameter, error) {
+	return e.TopocentricSemidiameterAt(body, e.NewInstant(jdTT), obs)
+}
+
+// TopocentricSemidiameterAt is like TopocentricSemidiameter but takes the
+// shared quantities from the instant.
+func TopocentricSemidiameterAt(body Body, in *Instant, obs Observer) (Semidiameter, error) {
+	return defaultEphemeris.TopocentricSemidiameterAt(body, in, obs)
+}
+
+// TopocentricSemidiameterAt is like TopocentricSemidiameter but takes the
+// shared quantities from the instant.
+func (e *Ephemeris) TopocentricSemidiameterAt(body Body, in *Instant, obs Observer) (Semidiameter, error) {
+	equ, err := e.TopocentricEquatorialPositionAt(body, in, obs)
+	if err != nil {
+		return Semidiameter{}, err
+	}
+	return e.semidiameter(body, in, equ.Radius)
+}
+
+// semidiameter returns the semidiameter of a body at distance dist (AU).
+func (e *Ephemeris) semidiameter(body Body, in *Instant, dist float64) (Semidiameter, error) {
+	switch body {
+	case Sun:
+		s := angularRadius(sunRadius, dist)
+		return Semidiameter{Equatorial: s, Polar: s}, nil
+	case Moon:
+		s := angularRadius(moonRadius, dist)
+		return Semidiameter{Equatorial: s, Polar: s}, nil
+	}
+	s0, ok := semidiameters0[body]
+	if !ok {
+		return Semidiameter{}, &UnsupportedBodyError{Body: body, Op: "semidiameter"}
+	}
+	k := math.Pi / 180 / 3600 / dist
+	res := Semidiameter{Equatorial: s0.Equatorial * k, Polar: s0.Polar * k}
+	if body == Saturn {
+		// Meeus, ch. 55: the polar axis is seen foreshortened by cos B
+		il, err := e.PlanetIlluminationAt(body, in)
+		if err != nil {
+			return Semidiameter{}, err
+		}
+		e2 := 1 - math.Pow(s0.Polar/s0.Equatorial, 2)
+		cosB := math.Cos(il.RingTilt)
+		res.Polar = res.Equatorial * math.Sqrt(1-e2*cosB*cosB)
+	}
+	return res, nil
+}
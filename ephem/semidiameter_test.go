@@ -0,0 +1,87 @@
+package ephem
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/ilbagatto/vsop87-go/mathutils"
+)
+
+// arcsec converts radians to arcseconds.
+func arcsec(rad float64) float64 {
+	return mathutils.Degrees(rad) * 3600
+}
+
+func TestApparentSemidiameter(t *testing.T) {
+	cases := []struct {
+		body     Body
+		jd       float64
+		eq, pol  float64 // arcseconds
+		tol      float64
+		describe string
+	}{
+		// Meeus, example 47.a: Δ = 368409.7 km; with the IAU k = 0.2725076
+		// (Meeus uses 0.272481 and gets 973.03″)
+		{Moon, 2448724.5, 973.12, 973.12, 0.05, "Moon, 1992 April 12"},
+		// Earth at perihelion, 2024 January 3: 16′15.9″
+		{Sun, 2460313.5, 975.9, 975.9, 0.2, "Sun, 2024 January 3"},
+		// Meeus, example 45.a: Δ = 10.464606, B = 16.442°
+		{Saturn, 2448972.5, 7.906, 7.459, 0.005, "Saturn, 1992 December 16"},
+	}
+	for _, c := range cases {
+		got, err := ApparentSemidiameter(c.body, c.jd)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !mathutils.AlmostEqual(arcsec(got.Equatorial), c.eq, c.tol) {
+			t.Errorf("%s: equatorial semidiameter should be %.3f″. Got: %.3f″", c.describe, c.eq, arcsec(got.Equatorial))
+		}
+		if !mathutils.AlmostEqual(arcsec(got.Polar), c.pol, c.tol) {
+			t.Errorf("%s: polar semidiameter should be %.3f″. Got: %.3f″", c.describe, c.pol, arcsec(got.Polar))
+		}
+	}
+}
+
+func TestTopocentricSemidiameterMoon(t *testing.T) {
+	// Meeus, ch. 55: s′ ≈ s·(1 + sin h·sin π), h being the Moon's altitude
+	const jd = 2448724.5
+	obs := Observer{Lat: mathutils.Radians(51.5), Lng: mathutils.Radians(-0.1)}
+	geo, err := ApparentSemidiameter(Moon, jd)
+	if err != nil {
+		t.Fatal(err)
+	}
+	topo, err := TopocentricSemidiameter(Moon, jd, obs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pos, err := EclipticPositionAt(Moon, NewInstant(jd))
+	if err != nil {
+		t.Fatal(err)
+	}
+	hor, err := HorizontalPosition(Moon, NewInstant(jd).UT(), obs, HorizontalOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := geo.Equatorial * (1 + math.Sin(hor.Altitude)*math.Sin(HorizontalParallax(pos.Radius)))
+	if !mathutils.AlmostEqual(arcsec(topo.Equatorial), arcsec(want), 0.2) {
+		t.Errorf("Topocentric semidiameter should be %.2f″. Got: %.2f″", arcsec(want), arcsec(topo.Equatorial))
+	}
+}
+
+func TestApparentSemidiameterUnsupported(t *testing.T) {
+	_, err := ApparentSemidiameter(Body(42), 2448724.5)
+	var ue *UnsupportedBodyError
+	if !errors.As(err, &ue) {
+		t.Errorf("Expected UnsupportedBodyError. Got: %v", err)
+	}
+}
+
+func TestApparentSemidiameterSaturnCustomBackend(t *testing.T) {
+	e := New(WithBackend(Saturn, fixedComputer(EclCoord{Lambda: 1, Radius: 9})))
+	_, err := e.ApparentSemidiameter(Saturn, 2448972.5)
+	var ue *UnsupportedBodyError
+	if !errors.As(err, &ue) || ue.Body != Saturn {
+		t.Errorf("Expected UnsupportedBodyError. Got: %v", err)
+	}
+}
@@ -0,0 +1,306 @@
+package ephem
+
+import (
+	"math"
+
+	"github.com/ilbagatto/vsop87-go/earth"
+	"github.com/ilbagatto/vsop87-go/internal/search"
+	"github.com/ilbagatto/vsop87-go/mathutils"
+	"github.com/ilbagatto/vsop87-go/timeutils"
+	"github.com/ilbagatto/vsop87-go/utils"
+)
+
+const (
+	// earthFlatteningGamma is the limit of gamma for a central eclipse,
+	// taking the Earth's flattening into account.
+	earthFlatteningGamma = 0.9972
+	// besselianSpan is the half-width of the interval over which Besselian
+	// elements are fitted, hours.
+	besselianSpan = 3
+)
+
+// SolarEclipseType is the kind of a solar eclipse.
+type SolarEclipseType int
+
+const (
+	// PartialSolarEclipse: only the penumbra touches the Earth.
+	PartialSolarEclipse SolarEclipseType = iota
+	// AnnularSolarEclipse: the antumbra touches the Earth.
+	AnnularSolarEclipse
+	// TotalSolarEclipse: the umbra touches the Earth.
+	TotalSolarEclipse
+	// HybridSolarEclipse: annular at the ends of the path, total in the middle.
+	HybridSolarEclipse
+)
+
+var solarEclipseNames = [...]string{"partial", "annular", "total", "hybrid"}
+
+func (t SolarEclipseType) String() string {
+	if t < 0 || int(t) >= len(solarEclipseNames) {
+		return "unknown"
+	}
+	return solarEclipseNames[t]
+}
+
+// Besselian holds the Besselian elements of a solar eclipse at one moment.
+// Lengths are in Earth equatorial radii, angles in radians.
+type Besselian struct {
+	X, Y  float64 // coordinates of the shadow axis in the fundamental plane
+	D     float64 // declination of the shadow axis
+	Mu    float64 // ephemeris hour angle of the shadow axis (sidereal time reckoned in TT)
+	L1    float64 // radius of the penumbra in the fundamental plane
+	L2    float64 // radius of the umbra, negative when the umbra reaches the plane
+	TanF1 float64 // tangent of the half-angle of the penumbral cone
+	TanF2 float64 // tangent of the half-angle of the umbral cone
+}
+
+// BesselianElements are polynomial fits of the Besselian elements,
+//
+//	X(t) = X[0] + X[1]·t + X[2]·t² + X[3]·t³
+//
+// and so on, where t = (JD − T0) in hours. The cone angles vary little and
+// are given as constants.
+type BesselianElements struct {
+	T0              float64 // reference time, Julian Day (TT)
+	X, Y, D, L1, L2 [4]float64
+	Mu              [4]float64
+	TanF1, TanF2    float64
+}
+
+// At evaluates the elements at jd (TT). Mu is reduced to [0, 2π).
+func (b BesselianElements) At(jd float64) Besselian {
+	t := (jd - b.T0) * 24
+	return Besselian{
+		X:     mathutils.Polynome(t, b.X[:]...),
+		Y:     mathutils.Polynome(t, b.Y[:]...),
+		D:     mathutils.Polynome(t, b.D[:]...),
+		Mu:    mathutils.ReduceRad(mathutils.Polynome(t, b.Mu[:]...)),
+		L1:    mathutils.Polynome(t, b.L1[:]...),
+		L2:    mathutils.Polynome(t, b.L2[:]...),
+		TanF1: b.TanF1,
+		TanF2: b.TanF2,
+	}
+}
+
+// SolarEclipse describes a solar eclipse as seen from the whole Earth.
+type SolarEclipse struct {
+	Type      SolarEclipseType
+	Central   bool    // the shadow axis meets the Earth
+	Greatest  float64 // Julian Day (TT) of the least distance of the shadow axis from the Earth's centre
+	Gamma     float64 // that distance, Earth radii; positive when the axis passes north
+	Magnitude float64 // fraction of the solar diameter covered at greatest eclipse
+	Elements  BesselianElements
+}
+
+// SolarEclipses returns the solar eclipses between start and end (Julian
+// Days, TT), in chronological order.
+func SolarEclipses(start, end float64) ([]SolarEclipse, error) {
+	return defaultEphemeris.SolarEclipses(start, end)
+}
+
+// SolarEclipses returns the solar eclipses between start and end, using the
+// ephemeris. The reduction should be apparent and the frame of date.
+//
+// Every New Moon is examined: the greatest eclipse is the least distance of
+// the shadow axis from the Earth's centre in the fundamental plane, and the
+// eclipse is classified from gamma and the radius of the umbra there.
+func (e *Ephemeris) SolarEclipses(start, end float64) ([]SolarEclipse, error) {
+	phases, err := e.LunarPhases(start, end)
+	if err != nil {
+		return nil, err
+	}
+	var res []SolarEclipse
+	for _, p := range phases {
+		if p.Phase != NewMoon {
+			continue
+		}
+		ecl, ok, err := e.solarEclipse(p.JD)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			res = append(res, ecl)
+		}
+	}
+	return res, nil
+}
+
+// BesselianAt returns the Besselian elements at jd (TT), computed from the
+// positions of the Sun and the Moon.
+func BesselianAt(jd float64) (Besselian, error) {
+	return defaultEphemeris.BesselianAt(jd)
+}
+
+// BesselianAt returns the Besselian elements at jd (TT), using the
+// ephemeris.
+func (e *Ephemeris) BesselianAt(jd float64) (Besselian, error) {
+	in := e.NewInstant(jd)
+	sun, err := e.EquatorialPositionAt(Sun, in)
+	if err != nil {
+		return Besselian{}, err
+	}
+	moon, err := e.EquatorialPositionAt(Moon, in)
+	if err != nil {
+		return Besselian{}, err
+	}
+	s := equToRect(sun, earthRadii(sun.Radius))
+	m := equToRect(moon, earthRadii(moon.Radius))
+
+	// direction of the shadow axis, from the Moon to the Sun
+	g := mathutils.Point3D{X: s.X - m.X, Y: s.Y - m.Y, Z: s.Z - m.Z}
+	gl := math.Sqrt(g.X*g.X + g.Y*g.Y + g.Z*g.Z)
+	a := math.Atan2(g.Y, g.X)
+	d := math.Asin(g.Z / gl)
+
+	// the Moon in the fundamental plane, z towards the Sun
+	sa, ca := math.Sincos(a)
+	sd, cd := math.Sincos(d)
+	x := -m.X*sa + m.Y*ca
+	y := -m.X*sd*ca - m.Y*sd*sa + m.Z*cd
+	z := m.X*cd*ca + m.Y*cd*sa + m.Z*sd
+
+	f1 := math.Asin((sunRadius + moonRadius) / gl)
+	f2 := math.Asin((sunRadius - moonRadiusValleys) / gl)
+	return Besselian{
+		X:     x,
+		Y:     y,
+		D:     d,
+		Mu:    mathutils.ReduceRad(ephemerisSiderealTime(in) - a),
+		L1:    z*math.Tan(f1) + moonRadius/math.Cos(f1),
+		L2:    z*math.Tan(f2) - moonRadiusValleys/math.Cos(f2),
+		TanF1: math.Tan(f1),
+		TanF2: math.Tan(f2),
+	}, nil
+}
+
+// FitBesselian fits cubic polynomials to the Besselian elements over
+// ±3 hours around t0 (Julian Day, TT).
+func FitBesselian(t0 float64) (BesselianElements, error) {
+	return defaultEphemeris.FitBesselian(t0)
+}
+
+// FitBesselian fits cubic polynomials to the Besselian elements over
+// ±3 hours around t0, using the ephemeris.
+func (e *Ephemeris) FitBesselian(t0 float64) (BesselianElements, error) {
+	var ts []float64
+	var x, y, d, mu, l1, l2 []float64
+	var tanF1, tanF2 float64
+	for h := -besselianSpan; h <= besselianSpan; h++ {
+		b, err := e.BesselianAt(t0 + float64(h)/24)
+		if err != nil {
+			return BesselianElements{}, err
+		}
+		ts = append(ts, float64(h))
+		x, y, d, l1, l2 = append(x, b.X), append(y, b.Y), append(d, b.D), append(l1, b.L1), append(l2, b.L2)
+		// μ increases by about 15° an hour; unwrap it
+		if n := len(mu); n > 0 {
+			b.Mu = mu[n-1] + mathutils.ReduceRad(b.Mu-mu[n-1])
+		}
+		mu = append(mu, b.Mu)
+		if h == 0 {
+			tanF1, tanF2 = b.TanF1, b.TanF2
+		}
+	}
+	elems := BesselianElements{T0: t0, TanF1: tanF1, TanF2: tanF2}
+	for _, f := range []struct {
+		dst *[4]float64
+		src []float64
+	}{
+		{&elems.X, x}, {&elems.Y, y}, {&elems.D, d}, {&elems.Mu, mu}, {&elems.L1, l1}, {&elems.L2, l2},
+	} {
+		copy(f.dst[:], mathutils.PolyFit(ts, f.src, 3))
+	}
+	return elems, nil
+}
+
+// solarEclipse examines the New Moon at jd and reports whether there is an
+// eclipse.
+func (e *Ephemeris) solarEclipse(jd float64) (SolarEclipse, bool, error) {
+	b, err := e.BesselianAt(jd)
+	if err != nil || math.Hypot(b.X, b.Y) > 2 {
+		return SolarEclipse{}, false, err
+	}
+	dist := func(t float64) (float64, error) {
+		b, err := e.BesselianAt(t)
+		return math.Hypot(b.X, b.Y), err
+	}
+	roots, err := search.Roots(search.Derivative(dist, 1.0/1440), jd-0.2, jd+0.2, 0.05, crossingTol, 0)
+	if err != nil {
+		return SolarEclipse{}, false, err
+	}
+	greatest := jd
+	for _, r := range roots {
+		if r.Rising {
+			greatest = r.JD
+			break
+		}
+	}
+	if b, err = e.BesselianAt(greatest); err != nil {
+		return SolarEclipse{}, false, err
+	}
+	m := math.Hypot(b.X, b.Y)
+	if m > 1+b.L1 {
+		return SolarEclipse{}, false, nil
+	}
+
+	ecl := SolarEclipse{Greatest: greatest, Gamma: math.Copysign(m, b.Y)}
+	switch {
+	case m < earthFlatteningGamma:
+		// radii of the shadows on the surface under the axis
+		zeta := math.Sqrt(1 - m*m)
+		l1 := b.L1 - zeta*b.TanF1
+		l2 := b.L2 - zeta*b.TanF2
+		ecl.Central = true
+		ecl.Magnitude = (l1 - l2) / (l1 + l2)
+		switch {
+		case l2 >= 0:
+			ecl.Type = AnnularSolarEclipse
+		case b.L2 > 0:
+			// at the ends of the path, where ζ = 0, the umbra falls short
+			ecl.Type = HybridSolarEclipse
+		default:
+			ecl.Type = TotalSolarEclipse
+		}
+	default:
+		ecl.Magnitude = (b.L1 - (m - earthFlatteningGamma)) / (b.L1 + b.L2)
+		switch {
+		case m < earthFlatteningGamma+math.Abs(b.L2) && b.L2 < 0:
+			ecl.Type = TotalSolarEclipse
+		case m < earthFlatteningGamma+math.Abs(b.L2):
+			ecl.Type = AnnularSolarEclipse
+		default:
+			ecl.Type = PartialSolarEclipse
+		}
+	}
+
+	t0 := math.Round(greatest*24) / 24 // nearest hour
+	if ecl.Elements, err = e.FitBesselian(t0); err != nil {
+		return SolarEclipse{}, false, err
+	}
+	return ecl, true, nil
+}
+
+// ephemerisSiderealTime returns the apparent sidereal time at the instant
+// taken as UT, radians. Besselian elements refer to the ephemeris meridian,
+// which lies 1.002738·ΔT east of Greenwich, so that they do not depend on ΔT.
+func ephemerisSiderealTime(in *Instant) float64 {
+	deltaPsi, _ := in.Nutation()
+	gst := timeutils.JulianToSidereal(in.JD(), timeutils.SiderealOptions{
+		Dpsi: mathutils.Degrees(deltaPsi),
+		Eps:  mathutils.Degrees(in.TrueObliquity()),
+	})
+	return mathutils.Radians(gst * 15)
+}
+
+// earthRadii converts a distance in AU to Earth equatorial radii.
+func earthRadii(au float64) float64 {
+	return utils.AuToKm(au) / earth.IAU1976.A
+}
+
+// equToRect returns the rectangular equatorial coordinates of a position
+// at distance r.
+func equToRect(p EquCoord, r float64) mathutils.Point3D {
+	sa, ca := math.Sincos(p.Alpha)
+	sd, cd := math.Sincos(p.Delta)
+	return mathutils.Point3D{X: r * cd * ca, Y: r * cd * sa, Z: r * sd}
+}
@@ -0,0 +1,86 @@
+package ephem
+
+import (
+	"math"
+	"testing"
+
+	"github.com/ilbagatto/vsop87-go/mathutils"
+)
+
+func TestSolarEclipses(t *testing.T) {
+	// NASA canon, 2022 October to 2024 October
+	exp := []struct {
+		typ              SolarEclipseType
+		greatest         float64 // TT
+		gamma, magnitude float64
+	}{
+		{PartialSolarEclipse, 2459877.9592, 1.0701, 0.8619},  // 2022 Oct 25
+		{HybridSolarEclipse, 2460054.6791, -0.3952, 1.0132},  // 2023 Apr 20
+		{AnnularSolarEclipse, 2460232.2505, 0.3753, 0.9520},  // 2023 Oct 14
+		{TotalSolarEclipse, 2460409.2629, 0.3431, 1.0566},    // 2024 Apr 8
+		{AnnularSolarEclipse, 2460586.2821, -0.3509, 0.9326}, // 2024 Oct 2
+	}
+	got, err := SolarEclipses(2459860.5, 2460600.5)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != len(exp) {
+		t.Fatalf("Expected %d eclipses. Got: %v", len(exp), got)
+	}
+	for i, ecl := range got {
+		if ecl.Type != exp[i].typ || ecl.Central != (exp[i].typ != PartialSolarEclipse) {
+			t.Errorf("Eclipse #%d should be %s. Got: %s", i, exp[i].typ, ecl.Type)
+		}
+		if math.Abs(ecl.Greatest-exp[i].greatest) > 2.0/1440 {
+			t.Errorf("Eclipse #%d: greatest should be at %.4f. Got: %.4f", i, exp[i].greatest, ecl.Greatest)
+		}
+		if !mathutils.AlmostEqual(ecl.Gamma, exp[i].gamma, 0.001) {
+			t.Errorf("Eclipse #%d: gamma should be %.4f. Got: %.4f", i, exp[i].gamma, ecl.Gamma)
+		}
+		if !mathutils.AlmostEqual(ecl.Magnitude, exp[i].magnitude, 0.001) {
+			t.Errorf("Eclipse #%d: magnitude should be %.4f. Got: %.4f", i, exp[i].magnitude, ecl.Magnitude)
+		}
+	}
+}
+
+func TestBesselianElements(t *testing.T) {
+	// NASA elements of 2024 April 8, T0 = 18h TT
+	const t0 = 2460409.25
+	got, err := FitBesselian(t0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		name     string
+		got, exp float64
+	}{
+		{"x0", got.X[0], -0.318244},
+		{"x1", got.X[1], 0.5117116},
+		{"y0", got.Y[0], 0.219764},
+		{"y1", got.Y[1], 0.2709589},
+		{"d0", mathutils.Degrees(got.D[0]), 7.5862},
+		{"l1", got.L1[0], 0.535814},
+		{"l2", got.L2[0], -0.010272},
+		{"mu0", mathutils.Degrees(got.Mu[0]), 89.591217},
+		{"mu1", mathutils.Degrees(got.Mu[1]), 15.004080},
+		{"tan f1", got.TanF1, 0.0046683},
+		{"tan f2", got.TanF2, 0.0046450},
+	}
+	for _, tc := range tests {
+		if !mathutils.AlmostEqual(tc.got, tc.exp, 1e-3) {
+			t.Errorf("%s should be %.6f. Got: %.6f", tc.name, tc.exp, tc.got)
+		}
+	}
+
+	// the fit reproduces the instantaneous elements
+	jd := t0 + 1.5/24
+	b, err := BesselianAt(jd)
+	if err != nil {
+		t.Fatal(err)
+	}
+	fit := got.At(jd)
+	if !mathutils.AlmostEqual(fit.X, b.X, 1e-6) || !mathutils.AlmostEqual(fit.Y, b.Y, 1e-6) ||
+		!mathutils.AlmostEqual(fit.Mu, b.Mu, 1e-6) || !mathutils.AlmostEqual(fit.L2, b.L2, 1e-6) {
+		t.Errorf("Fitted elements %+v should match %+v", fit, b)
+	}
+}
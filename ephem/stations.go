@@ -0,0 +1,80 @@
+package ephem
+
+import "github.com/ilbagatto/vsop87-go/internal/search"
+
+// stationTol is the accuracy of station times, in days (about 1 s).
+const stationTol = 1e-5
+
+// StationType tells whether a planet turns retrograde or direct.
+type StationType int
+
+const (
+	// StationRetrograde (SR): the longitude speed changes from positive to negative.
+	StationRetrograde StationType = iota
+	// StationDirect (SD): the longitude speed changes from negative to positive.
+	StationDirect
+)
+
+func (t StationType) String() string {
+	if t == StationDirect {
+		return "SD"
+	}
+	return "SR"
+}
+
+// Station is a moment when the geocentric longitude of a planet stops
+// changing.
+type Station struct {
+	JD     float64 // Julian Day (TT)
+	Lambda float64 // ecliptic longitude, radians
+	Type   StationType
+}
+
+// Stations returns all stations of a planet between start and end (Julian
+// Days, TT) in chronological order. The Sun and the Moon never station;
+// for them an *UnsupportedBodyError is returned.
+func Stations(body Body, start, end float64) ([]Station, error) {
+	return defaultEphemeris.Stations(body, start, end)
+}
+
+// Stations returns all stations of a planet between start and end (Julian
+// Days, TT), using the reduction and frame of the ephemeris.
+func (e *Ephemeris) Stations(body Body, start, end float64) ([]Station, error) {
+	if body == Sun || body == Moon {
+		return nil, &UnsupportedBodyError{Body: body, Op: "stations"}
+	}
+	speed := func(jd float64) (float64, error) {
+		_, v, err := e.EclipticPositionWithVelocity(body, jd)
+		return v, err
+	}
+	roots, err := search.Roots(speed, start, end, stationStep(body), stationTol, 0)
+	if err != nil {
+		return nil, err
+	}
+	stations := make([]Station, len(roots))
+	for i, r := range roots {
+		pos, _, err := e.EclipticPositionWithVelocity(body, r.JD)
+		if err != nil {
+			return nil, err
+		}
+		stations[i] = Station{JD: r.JD, Lambda: pos.Lambda, Type: StationRetrograde}
+		if r.Rising {
+			stations[i].Type = StationDirect
+		}
+	}
+	return stations, nil
+}
+
+// stationStep returns the scan step, in days. It must be shorter than the
+// shortest interval between two stations of the body: about 20 days for
+// Mercury, 40 for Venus and 60 for Mars.
+func stationStep(body Body) float64 {
+	switch body {
+	case Mercury, Venus:
+		return 1
+	case Mars:
+		return 2
+	default:
+		return 5
+	}
+}
@@ -0,0 +1,53 @@
+package ephem
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestStations(t *testing.T) {
+	// Mercury in 2024 after Jan 2: SR Apr 1, SD Apr 25, SR Aug 5, SD Aug 28, SR Nov 26, SD Dec 15
+	const start, end = 2460315.5, 2460676.5
+	got, err := Stations(Mercury, start, end)
+	if err != nil {
+		t.Fatal(err)
+	}
+	exp := []struct {
+		jd  float64
+		typ StationType
+	}{
+		{2460402, StationRetrograde},
+		{2460426, StationDirect},
+		{2460528, StationRetrograde},
+		{2460551, StationDirect},
+		{2460641, StationRetrograde},
+		{2460660, StationDirect},
+	}
+	if len(got) != len(exp) {
+		t.Fatalf("Expected %d stations. Got: %v", len(exp), got)
+	}
+	for i, s := range got {
+		if math.Abs(s.JD-exp[i].jd) > 1 || s.Type != exp[i].typ {
+			t.Errorf("Station #%d should be %s near %.1f. Got: %s at %.4f", i, exp[i].typ, exp[i].jd, s.Type, s.JD)
+		}
+		pos, v, _ := EclipticPositionWithVelocity(Mercury, s.JD)
+		// a second of time at the usual speed of a few arcminutes per day
+		if math.Abs(v) > 1e-7 {
+			t.Errorf("Speed at station #%d should be 0. Got: %g", i, v)
+		}
+		if pos.Lambda != s.Lambda {
+			t.Errorf("Longitude at station #%d should be %.8f. Got: %.8f", i, pos.Lambda, s.Lambda)
+		}
+	}
+}
+
+func TestStationsUnsupported(t *testing.T) {
+	for _, b := range []Body{Sun, Moon} {
+		_, err := Stations(b, 2451545.0, 2451910.0)
+		var ube *UnsupportedBodyError
+		if !errors.As(err, &ube) || ube.Body != b {
+			t.Errorf("%s: expected UnsupportedBodyError. Got: %v", b, err)
+		}
+	}
+}
@@ -0,0 +1,105 @@
+package ephem
+
+import (
+	"math"
+
+	"github.com/ilbagatto/vsop87-go/coco"
+	"github.com/ilbagatto/vsop87-go/earth"
+	"github.com/ilbagatto/vsop87-go/utils"
+)
+
+// HorizontalParallax returns the equatorial horizontal parallax (radians)
+// of a body at geocentric distance dist (AU). It always uses the IAU 1976
+// equatorial radius of the Earth, whatever the ellipsoid of an Observer;
+// the topocentric positions take the radius from the observer's ellipsoid.
+func HorizontalParallax(dist float64) float64 {
+	return parallax(earth.IAU1976, dist)
+}
+
+// parallax returns the equatorial horizontal parallax (radians) of a body
+// at geocentric distance dist (AU) for the equatorial radius of ell.
+func parallax(ell earth.Ellipsoid, dist float64) float64 {
+	return math.Asin(ell.A / utils.AuToKm(dist))
+}
+
+// TopocentricEquatorialPosition returns apparent equatorial coordinates of
+// date of any body, as seen by the observer at the given JD(TT).
+// Radius is the topocentric distance (AU).
+func TopocentricEquatorialPosition(body Body, jdTT float64, obs Observer) (EquCoord, error) {
+	return defaultEphemeris.TopocentricEquatorialPosition(body, jdTT, obs)
+}
+
+// TopocentricEquatorialPosition returns apparent equatorial coordinates of
+// date of any body, as seen by the observer at the given JD(TT).
+func (e *Ephemeris) TopocentricEquatorialPosition(body Body, jdTT float64, obs Observer) (EquCoord, error) {
+	return e.TopocentricEquatorialPositionAt(body, e.NewInstant(jdTT), obs)
+}
+
+// TopocentricEquatorialPositionAt is like TopocentricEquatorialPosition but
+// takes the shared quantities from the instant.
+func TopocentricEquatorialPositionAt(body Body, in *Instant, obs Observer) (EquCoord, error) {
+	return defaultEphemeris.TopocentricEquatorialPositionAt(body, in, obs)
+}
+
+// TopocentricEquatorialPositionAt is like TopocentricEquatorialPosition but
+// takes the shared quantities from the instant.
+func (e *Ephemeris) TopocentricEquatorialPositionAt(body Body, in *Instant, obs Observer) (EquCoord, error) {
+	equ, err := e.equatorialAt(body, in, Apparent, FrameOfDate)
+	if err != nil {
+		return EquCoord{}, err
+	}
+	return topocentric(equ, in.SiderealTime()+obs.Lng, obs), nil
+}
+
+// TopocentricEclipticPosition returns apparent ecliptic coordinates of date
+// of any body, as seen by the observer at the given JD(TT).
+// Radius is the topocentric distance (AU).
+func TopocentricEclipticPosition(body Body, jdTT float64, obs Observer) (EclCoord, error) {
+	return defaultEphemeris.TopocentricEclipticPosition(body, jdTT, obs)
+}
+
+// TopocentricEclipticPosition returns apparent ecliptic coordinates of date
+// of any body, as seen by the observer at the given JD(TT).
+func (e *Ephemeris) TopocentricEclipticPosition(body Body, jdTT float64, obs Observer) (EclCoord, error) {
+	return e.TopocentricEclipticPositionAt(body, e.NewInstant(jdTT), obs)
+}
+
+// TopocentricEclipticPositionAt is like TopocentricEclipticPosition but
+// takes the shared quantities from the instant.
+func TopocentricEclipticPositionAt(body Body, in *Instant, obs Observer) (EclCoord, error) {
+	return defaultEphemeris.TopocentricEclipticPositionAt(body, in, obs)
+}
+
+// TopocentricEclipticPositionAt is like TopocentricEclipticPosition but
+// takes the shared quantities from the instant.
+func (e *Ephemeris) TopocentricEclipticPositionAt(body Body, in *Instant, obs Observer) (EclCoord, error) {
+	equ, err := e.TopocentricEquatorialPositionAt(body, in, obs)
+	if err != nil {
+		return EclCoord{}, err
+	}
+	lam, bet := coco.Equ2Ecl(equ.Alpha, equ.Delta, in.TrueObliquity())
+	return EclCoord{Lambda: lam, Beta: bet, Radius: equ.Radius}, nil
+}
+
+// topocentric applies diurnal parallax to geocentric equatorial coordinates
+// (Meeus, formulae 40.2 and 40.3). lst is the local apparent sidereal time
+// (radians). The parallax and the observer's parallax factors refer to the
+// same ellipsoid.
+func topocentric(equ EquCoord, lst float64, obs Observer) EquCoord {
+	rhoSin, rhoCos := obs.ParallaxFactors()
+	sinPi := math.Sin(parallax(obs.ellipsoid(), equ.Radius))
+	h := lst - equ.Alpha
+	cosDec := math.Cos(equ.Delta)
+
+	// components of the topocentric direction, in units of the geocentric distance
+	a := cosDec - rhoCos*sinPi*math.Cos(h)
+	b := -rhoCos * sinPi * math.Sin(h)
+	c := math.Sin(equ.Delta) - rhoSin*sinPi
+
+	dAlpha := math.Atan2(b, a)
+	return EquCoord{
+		Alpha:  equ.Alpha + dAlpha,
+		Delta:  math.Atan2(c*math.Cos(dAlpha), a),
+		Radius: equ.Radius * math.Sqrt(a*a+b*b+c*c),
+	}
+}
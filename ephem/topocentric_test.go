@@ -0,0 +1,72 @@
+package ephem
+
+import (
+	"math"
+	"testing"
+
+	"github.com/ilbagatto/vsop87-go/earth"
+	"github.com/ilbagatto/vsop87-go/mathutils"
+	"github.com/ilbagatto/vsop87-go/utils"
+)
+
+func TestTopocentricMars(t *testing.T) {
+	// Meeus, p.280: Mars from Palomar, 2003 August 28, 3h17m UT
+	const threshold = 1e-4
+	geo := EquCoord{
+		Alpha:  mathutils.Radians(339.530208),
+		Delta:  mathutils.Radians(-15.771083),
+		Radius: 0.37276,
+	}
+	h := mathutils.Radians(288.7958)
+	obs := Observer{
+		Lat:       mathutils.Radians(33 + 21.0/60 + 22.0/3600),
+		Elevation: 1706,
+		Ellipsoid: earth.IAU1976,
+	}
+	got := topocentric(geo, geo.Alpha+h, obs)
+
+	expA := 339.535583 // 22h38m08.54s
+	expD := -15.775000 // −15°46′30.0″
+	gotA := mathutils.Degrees(got.Alpha)
+	gotD := mathutils.Degrees(got.Delta)
+	if !mathutils.AlmostEqual(gotA, expA, threshold) {
+		t.Errorf("Alpha should be %.6f. Got: %.6f", expA, gotA)
+	}
+	if !mathutils.AlmostEqual(gotD, expD, threshold) {
+		t.Errorf("Delta should be %.6f. Got: %.6f", expD, gotD)
+	}
+}
+
+func TestTopocentricMoonDistance(t *testing.T) {
+	// The Moon at the observer's zenith is about one Earth radius closer.
+	const jd = 2448724.5
+	geo, err := EquatorialPosition(Moon, jd)
+	if err != nil {
+		t.Fatalf("EquatorialPosition: %v", err)
+	}
+	obs := Observer{Lat: geo.Delta}
+	got := topocentric(geo, geo.Alpha, obs)
+
+	exp := geo.Radius - utils.KmToAU(earth.WGS84.A)
+	if !mathutils.AlmostEqual(got.Radius, exp, 1e-7) {
+		t.Errorf("Distance should be %.8f. Got: %.8f", exp, got.Radius)
+	}
+}
+
+func TestTopocentricEllipsoidRadius(t *testing.T) {
+	// The parallax follows the observer's ellipsoid, not the IAU 1976 radius.
+	const jd = 2448724.5
+	geo, err := EquatorialPosition(Moon, jd)
+	if err != nil {
+		t.Fatalf("EquatorialPosition: %v", err)
+	}
+	ell := earth.Ellipsoid{A: 7000, F: 1 / 298.257}
+	obs := Observer{Lat: geo.Delta, Ellipsoid: ell}
+	got := topocentric(geo, geo.Alpha, obs)
+
+	rhoSin, rhoCos := obs.ParallaxFactors()
+	exp := geo.Radius - utils.KmToAU(ell.A*math.Hypot(rhoSin, rhoCos))
+	if !mathutils.AlmostEqual(got.Radius, exp, 1e-9) {
+		t.Errorf("Distance should be %.9f. Got: %.9f", exp, got.Radius)
+	}
+}
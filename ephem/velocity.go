@@ -0,0 +1,135 @@
+package ephem
+
+import (
+	"math"
+
+	"github.com/ilbagatto/vsop87-go/internal/moon"
+)
+
+// EclVelocity holds the rates of change of ecliptic coordinates.
+type EclVelocity struct {
+	Lambda float64 // longitude (radians/day)
+	Beta   float64 // latitude (radians/day)
+	Radius float64 // distance (AU/day)
+}
+
+// EquVelocity holds the rates of change of equatorial coordinates.
+type EquVelocity struct {
+	Alpha  float64 // right ascension (radians/day)
+	Delta  float64 // declination (radians/day)
+	Radius float64 // distance (AU/day)
+}
+
+// EclipticState returns geocentric ecliptic coordinates and their rates of
+// change at the given JD(TT). The rates of the Sun and the planets come from
+// the analytic derivatives of the VSOP87 series, those of the Moon from its
+// analytic series; for Pluto and custom backends they are obtained by
+// central difference.
+func EclipticState(body Body, jdTT float64) (EclCoord, EclVelocity, error) {
+	return defaultEphemeris.EclipticState(body, jdTT)
+}
+
+// EclipticState returns geocentric ecliptic coordinates and their rates of
+// change at the given JD(TT).
+func (e *Ephemeris) EclipticState(body Body, jdTT float64) (EclCoord, EclVelocity, error) {
+	return e.EclipticStateAt(body, e.NewInstant(jdTT))
+}
+
+// EclipticStateAt is like EclipticState but uses the instant and its
+// memoized offsets.
+func EclipticStateAt(body Body, in *Instant) (EclCoord, EclVelocity, error) {
+	return defaultEphemeris.EclipticStateAt(body, in)
+}
+
+// EclipticStateAt is like EclipticState but uses the instant and its
+// memoized offsets, so that all rates share the same neighbouring positions.
+func (e *Ephemeris) EclipticStateAt(body Body, in *Instant) (EclCoord, EclVelocity, error) {
+	// Analytic branches where supported
+	if s, ok := e.computers[body].(stateReducer); ok {
+		pos, vel := s.state(in, e.reduction, e.frame)
+		return pos, vel, nil
+	}
+	p0, err := e.EclipticPositionAt(body, in)
+	if err != nil {
+		return EclCoord{}, EclVelocity{}, err
+	}
+	if _, ok := e.computers[body].(moonWrapper); ok {
+		dLambda, dBeta, dRadius := moon.Rates(in.JD())
+		return p0, EclVelocity{
+			Lambda: dLambda,
+			Beta:   dBeta,
+			Radius: dRadius,
+		}, nil
+	}
+
+	// ±h positions
+	h := stepFor(body)
+	pp, err := e.EclipticPositionAt(body, in.Offset(h))
+	if err != nil {
+		return EclCoord{}, EclVelocity{}, err
+	}
+	pm, err := e.EclipticPositionAt(body, in.Offset(-h))
+	if err != nil {
+		return EclCoord{}, EclVelocity{}, err
+	}
+	return p0, EclVelocity{
+		Lambda: centralDiffRad(pp.Lambda, pm.Lambda, h),
+		Beta:   (pp.Beta - pm.Beta) / (2 * h),
+		Radius: (pp.Radius - pm.Radius) / (2 * h),
+	}, nil
+}
+
+// EquatorialState returns geocentric equatorial coordinates and their rates
+// of change at the given JD(TT). The rates are derived from those of the
+// ecliptic coordinates; the slow change of the obliquity is neglected.
+func EquatorialState(body Body, jdTT float64) (EquCoord, EquVelocity, error) {
+	return defaultEphemeris.EquatorialState(body, jdTT)
+}
+
+// EquatorialState returns geocentric equatorial coordinates and their rates
+// of change at the given JD(TT).
+func (e *Ephemeris) EquatorialState(body Body, jdTT float64) (EquCoord, EquVelocity, error) {
+	return e.EquatorialStateAt(body, e.NewInstant(jdTT))
+}
+
+// EquatorialStateAt is like EquatorialState but uses the instant and its
+// memoized offsets.
+func EquatorialStateAt(body Body, in *Instant) (EquCoord, EquVelocity, error) {
+	return defaultEphemeris.EquatorialStateAt(body, in)
+}
+
+// EquatorialStateAt is like EquatorialState but uses the instant and its
+// memoized offsets.
+func (e *Ephemeris) EquatorialStateAt(body Body, in *Instant) (EquCoord, EquVelocity, error) {
+	ecl, vel, err := e.EclipticStateAt(body, in)
+	if err != nil {
+		return EquCoord{}, EquVelocity{}, err
+	}
+	eps := obliquity(in, e.reduction, e.frame)
+	return eclToEqu(ecl, eps), eclToEquRate(ecl, vel, eps), nil
+}
+
+// eclToEquRate converts rates of ecliptic coordinates to rates of
+// equatorial ones, for obliquity eps (radians).
+func eclToEquRate(ecl EclCoord, vel EclVelocity, eps float64) EquVelocity {
+	sinL, cosL := math.Sincos(ecl.Lambda)
+	sinB, cosB := math.Sincos(ecl.Beta)
+	sinE, cosE := math.Sincos(eps)
+
+	// unit vector towards the body and its rate, ecliptic frame
+	x, y, z := cosB*cosL, cosB*sinL, sinB
+	dx := -cosB*sinL*vel.Lambda - sinB*cosL*vel.Beta
+	dy := cosB*cosL*vel.Lambda - sinB*sinL*vel.Beta
+	dz := cosB * vel.Beta
+
+	// rotate about the X axis to the equatorial frame
+	y, z = y*cosE-z*sinE, y*sinE+z*cosE
+	dy, dz = dy*cosE-dz*sinE, dy*sinE+dz*cosE
+
+	rho2 := x*x + y*y
+	return EquVelocity{
+		Alpha:  (x*dy - y*dx) / rho2,
+		Delta:  dz / math.Sqrt(rho2),
+		Radius: vel.Radius,
+	}
+}
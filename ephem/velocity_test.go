@@ -0,0 +1,88 @@
+package ephem
+
+import (
+	"testing"
+
+	"github.com/ilbagatto/vsop87-go/mathutils"
+)
+
+func TestEclipticState(t *testing.T) {
+	const (
+		jd = 2460000.5
+		h  = 1.0 / 24
+	)
+	for _, tc := range []struct {
+		body      Body
+		threshold float64
+	}{
+		{Moon, 1e-6}, // rates of the series of Meeus, ch. 47
+		{Sun, 1e-8},
+		{Mars, 1e-8},
+		{Pluto, 1e-8},
+	} {
+		pos, vel, err := EclipticState(tc.body, jd)
+		if err != nil {
+			t.Fatalf("EclipticState(%v): %v", tc.body, err)
+		}
+		p, _ := EclipticPositionAt(tc.body, NewInstant(jd+h))
+		m, _ := EclipticPositionAt(tc.body, NewInstant(jd-h))
+
+		if exp, _ := EclipticPosition(tc.body, jd, 0); exp.Radius != pos.Radius {
+			t.Errorf("%v: distance should be %.9f. Got: %.9f", tc.body, exp.Radius, pos.Radius)
+		}
+		if exp := centralDiffRad(p.Lambda, m.Lambda, h); !mathutils.AlmostEqual(vel.Lambda, exp, tc.threshold) {
+			t.Errorf("%v: longitude rate should be %.9f. Got: %.9f", tc.body, exp, vel.Lambda)
+		}
+		if exp := (p.Beta - m.Beta) / (2 * h); !mathutils.AlmostEqual(vel.Beta, exp, tc.threshold) {
+			t.Errorf("%v: latitude rate should be %.9f. Got: %.9f", tc.body, exp, vel.Beta)
+		}
+		if exp := (p.Radius - m.Radius) / (2 * h); !mathutils.AlmostEqual(vel.Radius, exp, tc.threshold) {
+			t.Errorf("%v: distance rate should be %.9f. Got: %.9f", tc.body, exp, vel.Radius)
+		}
+	}
+}
+
+func TestEclipticStateMoon(t *testing.T) {
+	// the longitude rate matches the position model at any date
+	const h = 1.0 / 1440
+	for _, jd := range []float64{2451545.0, 2455000.25, 2460000.5, 2460500.75} {
+		_, vel, err := EclipticState(Moon, jd)
+		if err != nil {
+			t.Fatal(err)
+		}
+		p, _ := EclipticPositionAt(Moon, NewInstant(jd+h))
+		m, _ := EclipticPositionAt(Moon, NewInstant(jd-h))
+		if exp := centralDiffRad(p.Lambda, m.Lambda, h); !mathutils.AlmostEqual(vel.Lambda, exp, 1e-6) {
+			t.Errorf("%.2f: longitude rate should be %.9f. Got: %.9f", jd, exp, vel.Lambda)
+		}
+	}
+}
+
+func TestEquatorialState(t *testing.T) {
+	const (
+		jd = 2460000.5
+		h  = 1.0 / 24
+	)
+	for _, tc := range []struct {
+		body      Body
+		threshold float64
+	}{
+		{Moon, 1e-4},
+		{Venus, 1e-6},
+		{Jupiter, 1e-6},
+	} {
+		_, vel, err := EquatorialState(tc.body, jd)
+		if err != nil {
+			t.Fatalf("EquatorialState(%v): %v", tc.body, err)
+		}
+		p, _ := EquatorialPosition(tc.body, jd+h)
+		m, _ := EquatorialPosition(tc.body, jd-h)
+
+		if exp := centralDiffRad(p.Alpha, m.Alpha, h); !mathutils.AlmostEqual(vel.Alpha, exp, tc.threshold) {
+			t.Errorf("%v: RA rate should be %.9f. Got: %.9f", tc.body, exp, vel.Alpha)
+		}
+		if exp := (p.Delta - m.Delta) / (2 * h); !mathutils.AlmostEqual(vel.Delta, exp, tc.threshold) {
+			t.Errorf("%v: declination rate should be %.9f. Got: %.9f", tc.body, exp, vel.Delta)
+		}
+	}
+}
@@ -3,6 +3,7 @@ package heliocentric
 import (
 	"math"
 
+	"github.com/ilbagatto/vsop87-go/internal/vsop87"
 	"github.com/ilbagatto/vsop87-go/mathutils"
 	"github.com/ilbagatto/vsop87-go/timeutils"
 )
@@ -48,8 +49,9 @@ func NutationEclLon(deltaPsi, eps0 float64) float64 {
 	return deltaPsi * math.Cos(eps0)
 }
 
-// HelioRect returns heliocentric coordinates of body at Julian Day jd.
-func getLBR(jd float64, body Heliocentric) mathutils.Spherical {
+// LBR returns heliocentric ecliptic spherical coordinates of body at Julian Day jd
+// (Phi = L, Theta = B in radians, R in AU).
+func LBR(jd float64, body Heliocentric) mathutils.Spherical {
 	tau := (jd - timeutils.J2000) / 365250
 	sph := mathutils.Spherical{
 		R:     body.RadiusVector(tau),
@@ -59,14 +61,49 @@ func getLBR(jd float64, body Heliocentric) mathutils.Spherical {
 	return sph
 }
 
-// searchApparent computes the apparent geocentric ecliptic coordinates
-// of body at Julian day jd by iteratively correcting for light-time.
-// Returns an error if convergence wasn’t reached within maxIter.
-func searchApparent(jd float64, body Heliocentric, earthLBR mathutils.Spherical) EclCoord {
+// LBRWithRate is like LBR but also returns the rates of change of L, B
+// (radians/day) and R (AU/day), from the analytic derivatives of the series.
+func LBRWithRate(jd float64, body Heliocentric) (pos, rate mathutils.Spherical) {
+	tau := (jd - timeutils.J2000) / 365250
+	s := seriesOf(body)
+	l, dl := vsop87.ComputeSeriesWithDerivative(tau, s[0])
+	b, db := vsop87.ComputeSeriesWithDerivative(tau, s[1])
+	r, dr := vsop87.ComputeSeriesWithDerivative(tau, s[2])
+	pos = mathutils.Spherical{R: r, Theta: b, Phi: mathutils.ReduceRad(l)}
+	rate = mathutils.Spherical{R: dr / 365250, Theta: db / 365250, Phi: dl / 365250}
+	return
+}
+
+// geocentric computes the geocentric ecliptic coordinates of body at
+// Julian day jd, referred to the mean ecliptic and equinox of date.
+// If lightTime is set, the body is taken at the moment the light left it,
+// found by iteration.
+func geocentric(jd float64, body Heliocentric, earthLBR mathutils.Spherical, lightTime bool) EclCoord {
+	rel, _ := geocentricVector(jd, body, earthLBR, lightTime)
+	sph := rel.ToSpherical()
+	return EclCoord{Lambda: sph.Phi, Beta: sph.Theta, Radius: sph.R}
+}
+
+// LightTimeLBR returns the heliocentric coordinates of body at the moment
+// the light reaching the Earth at jd left it, and the geocentric distance
+// (AU) travelled by that light.
+func LightTimeLBR(jd float64, body Heliocentric, earthLBR mathutils.Spherical) (helio mathutils.Spherical, delta float64) {
+	rel, helio := geocentricVector(jd, body, earthLBR, true)
+	return helio, math.Sqrt(rel.X*rel.X + rel.Y*rel.Y + rel.Z*rel.Z)
+}
+
+// geocentricVector returns the geocentric rectangular position of body and
+// its heliocentric coordinates, at the moment the light left it if
+// lightTime is set.
+func geocentricVector(jd float64, body Heliocentric, earthLBR mathutils.Spherical, lightTime bool) (rel mathutils.Point3D, helio mathutils.Spherical) {
 	earthRect := earthLBR.ToRectangular()
-	var rel mathutils.Point3D
-	for range 2 {
-		p := getLBR(jd, body).ToRectangular()
+	iterations := 1
+	if lightTime {
+		iterations = 2
+	}
+	for range iterations {
+		helio = LBR(jd, body)
+		p := helio.ToRectangular()
 		rel = mathutils.Point3D{
 			X: p.X - earthRect.X,
 			Y: p.Y - earthRect.Y,
@@ -77,8 +114,7 @@ func searchApparent(jd float64, body Heliocentric, earthLBR mathutils.Spherical)
 		tauDays := delta * LightTimeDaysPerAU
 		jd -= tauDays
 	}
-	sph := rel.ToSpherical()
-	return EclCoord{Lambda: sph.Phi, Beta: sph.Theta, Radius: sph.R}
+	return rel, helio
 }
 
 // ApparentGeocentric computes the apparent geocentric ecliptic coordinates of a celestial body.
@@ -98,15 +134,109 @@ func searchApparent(jd float64, body Heliocentric, earthLBR mathutils.Spherical)
 //	  R:      radius vector (AU)
 //	error if the light-time iteration fails to converge.
 func ApparentGeocentric(jd float64, body Heliocentric, deltaPsi float64) EclCoord {
-	// compute Earth's heliocentric rectangular coordinates
-	earthLBR := getLBR(jd, Earth{})
+	return ApparentGeocentricFrom(jd, body, deltaPsi, EarthLBR(jd))
+}
 
-	ecl := searchApparent(jd, body, earthLBR)
+// EarthLBR returns Earth's heliocentric ecliptic spherical coordinates
+// (Phi = L, Theta = B in radians, R in AU) at Julian day jd.
+func EarthLBR(jd float64) mathutils.Spherical {
+	return LBR(jd, Earth{})
+}
 
+// ApparentGeocentricFrom is like ApparentGeocentric but takes Earth's
+// heliocentric coordinates at jd, as returned by EarthLBR, so that they
+// can be computed once and shared by several bodies.
+func ApparentGeocentricFrom(jd float64, body Heliocentric, deltaPsi float64, earthLBR mathutils.Spherical) EclCoord {
+	return GeocentricFrom(jd, body, Apparent, FrameOfDate, deltaPsi, earthLBR)
+}
+
+// GeocentricFrom computes the geocentric ecliptic coordinates of body at
+// Julian day jd, reduced as r and referred to frame f. deltaPsi is used only
+// by apparent positions of date; earthLBR is Earth's heliocentric position
+// at jd, as returned by EarthLBR.
+func GeocentricFrom(jd float64, body Heliocentric, r Reduction, f Frame, deltaPsi float64, earthLBR mathutils.Spherical) EclCoord {
+	pos := geocentric(jd, body, earthLBR, r.LightTime())
 	sunL := earthLBR.Phi + math.Pi
-	aberL, aberB := Aberration(jd, ecl.Lambda, ecl.Beta, sunL)
+	return Reduce(r, f, jd, pos, deltaPsi, func(jd float64, pos EclCoord) (float64, float64) {
+		return Aberration(jd, pos.Lambda, pos.Beta, sunL)
+	})
+}
 
-	ecl.Lambda = mathutils.ReduceRad(ecl.Lambda + aberL + deltaPsi)
-	ecl.Beta += aberB
-	return ecl
+// GeocentricStateFrom is like GeocentricFrom but also returns the rates of
+// change of the coordinates: longitude and latitude in radians/day, radius
+// in AU/day. earthRate holds the rates of earthLBR, as returned by
+// LBRWithRate; deltaPsi is as in ReduceState.
+func GeocentricStateFrom(jd float64, body Heliocentric, r Reduction, f Frame, deltaPsi func(dt float64) float64, earthLBR, earthRate mathutils.Spherical) (pos, rate EclCoord) {
+	pos, rate = geocentricState(jd, body, earthLBR, earthRate, r.LightTime())
+	sunL := earthLBR.Phi + math.Pi
+	return ReduceState(r, f, jd, pos, rate, deltaPsi, func(t float64, pos EclCoord) (float64, float64) {
+		return Aberration(t, pos.Lambda, pos.Beta, sunL+earthRate.Phi*(t-jd))
+	})
+}
+
+// geocentricState is like geocentric but also returns the rates of change
+// of the coordinates, including the rate of change of the light-time.
+func geocentricState(jd float64, body Heliocentric, earthLBR, earthRate mathutils.Spherical, lightTime bool) (pos, rate EclCoord) {
+	e, de := rectState(earthLBR, earthRate)
+	iterations := 1
+	if lightTime {
+		iterations = 2
+	}
+	t := jd
+	var rel, p, dp mathutils.Point3D
+	for range iterations {
+		p, dp = rectState(LBRWithRate(t, body))
+		rel = mathutils.Point3D{X: p.X - e.X, Y: p.Y - e.Y, Z: p.Z - e.Z}
+		delta := math.Sqrt(rel.X*rel.X + rel.Y*rel.Y + rel.Z*rel.Z)
+		t = jd - delta*LightTimeDaysPerAU
+	}
+
+	// d(rel)/dt = dP·(1 − dτ/dt) − dE, where τ = k·|rel|
+	factor := 1.0
+	if lightTime {
+		delta := math.Sqrt(rel.X*rel.X + rel.Y*rel.Y + rel.Z*rel.Z)
+		a := (rel.X*dp.X + rel.Y*dp.Y + rel.Z*dp.Z) / delta
+		b := (rel.X*de.X + rel.Y*de.Y + rel.Z*de.Z) / delta
+		k := LightTimeDaysPerAU
+		factor = 1 - k*(a-b)/(1+k*a)
+	}
+	drel := mathutils.Point3D{
+		X: dp.X*factor - de.X,
+		Y: dp.Y*factor - de.Y,
+		Z: dp.Z*factor - de.Z,
+	}
+	return sphericalState(rel, drel)
+}
+
+// rectState converts spherical coordinates (Theta = latitude, Phi =
+// longitude) and their rates into rectangular position and velocity.
+func rectState(pos, rate mathutils.Spherical) (p, v mathutils.Point3D) {
+	sinB, cosB := math.Sincos(pos.Theta)
+	sinL, cosL := math.Sincos(pos.Phi)
+	p = mathutils.Point3D{
+		X: pos.R * cosB * cosL,
+		Y: pos.R * cosB * sinL,
+		Z: pos.R * sinB,
+	}
+	v = mathutils.Point3D{
+		X: rate.R*cosB*cosL - pos.R*sinB*cosL*rate.Theta - pos.R*cosB*sinL*rate.Phi,
+		Y: rate.R*cosB*sinL - pos.R*sinB*sinL*rate.Theta + pos.R*cosB*cosL*rate.Phi,
+		Z: rate.R*sinB + pos.R*cosB*rate.Theta,
+	}
+	return
+}
+
+// sphericalState converts rectangular position and velocity into ecliptic
+// coordinates and their rates.
+func sphericalState(p, v mathutils.Point3D) (pos, rate EclCoord) {
+	sph := p.ToSpherical()
+	rho := math.Hypot(p.X, p.Y)
+	dr := (p.X*v.X + p.Y*v.Y + p.Z*v.Z) / sph.R
+	pos = EclCoord{Lambda: sph.Phi, Beta: sph.Theta, Radius: sph.R}
+	rate = EclCoord{
+		Lambda: (p.X*v.Y - p.Y*v.X) / (rho * rho),
+		Beta:   (v.Z*sph.R - p.Z*dr) / (sph.R * rho),
+		Radius: dr,
+	}
+	return
 }
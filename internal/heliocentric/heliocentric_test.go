@@ -3,6 +3,8 @@ package heliocentric_test
 import (
 	"testing"
 
+	"github.com/ilbagatto/vsop87-go/coco"
+	"github.com/ilbagatto/vsop87-go/earth"
 	"github.com/ilbagatto/vsop87-go/internal/heliocentric"
 	"github.com/ilbagatto/vsop87-go/mathutils"
 )
@@ -57,3 +59,100 @@ func TestApparentVenusAgainstSweph(t *testing.T) {
 	}
 
 }
+
+func TestTruncatedVenus(t *testing.T) {
+	jd := 2448976.5 // 1992 December 20 at 0h TD
+	dPsi := mathutils.Radians(16.749 / 3600.0)
+	exp := heliocentric.ApparentGeocentric(jd, heliocentric.Venus{}, dPsi)
+	got := heliocentric.ApparentGeocentric(jd, heliocentric.NewTruncated(heliocentric.Venus{}, 1e-6), dPsi)
+
+	// 1e-6 radians amplitude keeps the position within a few arcseconds
+	thr := mathutils.Radians(5.0 / 3600)
+	if !mathutils.AlmostEqual(got.Lambda, exp.Lambda, thr) {
+		t.Errorf("Truncated L should be %.6f. Got: %.6f", exp.Lambda, got.Lambda)
+	}
+	if !mathutils.AlmostEqual(got.Beta, exp.Beta, thr) {
+		t.Errorf("Truncated B should be %.6f. Got: %.6f", exp.Beta, got.Beta)
+	}
+}
+
+func TestAstrometricVenusAgainstMeeus(t *testing.T) {
+	// Meeus, p.225: position corrected for light-time only
+	jd := 2448976.5 // 1992 December 20 at 0h TD
+	ecl := heliocentric.GeocentricFrom(jd, heliocentric.Venus{}, heliocentric.Astrometric, heliocentric.FrameDefault, 0, heliocentric.EarthLBR(jd))
+	l, b := coco.Astrometric2000ToMean(ecl.Lambda, ecl.Beta, jd)
+
+	expL := 313.08102
+	expB := -2.08474
+	expR := 0.910947
+
+	gotL := mathutils.Degrees(l)
+	gotB := mathutils.Degrees(b)
+
+	if !mathutils.AlmostEqual(gotL, expL, 1e-3) {
+		t.Errorf("L should be %.5f. Got: %.5f", expL, gotL)
+	}
+	if !mathutils.AlmostEqual(gotB, expB, 1e-3) {
+		t.Errorf("B should be %.5f. Got: %.5f", expB, gotB)
+	}
+	if !mathutils.AlmostEqual(ecl.Radius, expR, 1e-3) {
+		t.Errorf("R should be %.6f. Got: %.6f", expR, ecl.Radius)
+	}
+}
+
+func TestGeometricVenus(t *testing.T) {
+	// without light-time Venus is ahead by its motion during the light-time
+	jd := 2448976.5
+	earth := heliocentric.EarthLBR(jd)
+	geo := heliocentric.GeocentricFrom(jd, heliocentric.Venus{}, heliocentric.Geometric, heliocentric.FrameDefault, 0, earth)
+	ast := heliocentric.GeocentricFrom(jd, heliocentric.Venus{}, heliocentric.Astrometric, heliocentric.FrameDefault, 0, earth)
+	l, _ := coco.Astrometric2000ToMean(ast.Lambda, ast.Beta, jd)
+
+	if geo.Lambda <= l {
+		t.Errorf("Geometric L %.6f should exceed light-time corrected %.6f", geo.Lambda, l)
+	}
+}
+
+func TestGeocentricStateFrom(t *testing.T) {
+	const (
+		jd = 2448976.5
+		h  = 1.0 / 96
+	)
+	nutation := func(jd float64) float64 {
+		dpsi, _ := earth.Nutation(jd)
+		return dpsi
+	}
+	dpsi := func(dt float64) float64 { return nutation(jd + dt) }
+	position := func(jd float64, r heliocentric.Reduction, f heliocentric.Frame) heliocentric.EclCoord {
+		return heliocentric.GeocentricFrom(jd, heliocentric.Mars{}, r, f, nutation(jd), heliocentric.EarthLBR(jd))
+	}
+	earthLBR, earthRate := heliocentric.LBRWithRate(jd, heliocentric.Earth{})
+
+	for _, tc := range []struct {
+		name string
+		r    heliocentric.Reduction
+		f    heliocentric.Frame
+	}{
+		{"Apparent", heliocentric.Apparent, heliocentric.FrameOfDate},
+		{"Geometric", heliocentric.Geometric, heliocentric.FrameOfDate},
+		{"Astrometric", heliocentric.Astrometric, heliocentric.FrameJ2000},
+	} {
+		pos, rate := heliocentric.GeocentricStateFrom(jd, heliocentric.Mars{}, tc.r, tc.f, dpsi, earthLBR, earthRate)
+		if exp := position(jd, tc.r, tc.f); !mathutils.AlmostEqual(pos.Lambda, exp.Lambda, 1e-12) {
+			t.Errorf("%s: L should be %.12f. Got: %.12f", tc.name, exp.Lambda, pos.Lambda)
+		}
+		p, m := position(jd+h, tc.r, tc.f), position(jd-h, tc.r, tc.f)
+		expL := mathutils.AngNormPi(p.Lambda-m.Lambda) / (2 * h)
+		expB := (p.Beta - m.Beta) / (2 * h)
+		expR := (p.Radius - m.Radius) / (2 * h)
+		if !mathutils.AlmostEqual(rate.Lambda, expL, 1e-8) {
+			t.Errorf("%s: dL should be %.10f. Got: %.10f", tc.name, expL, rate.Lambda)
+		}
+		if !mathutils.AlmostEqual(rate.Beta, expB, 1e-8) {
+			t.Errorf("%s: dB should be %.10f. Got: %.10f", tc.name, expB, rate.Beta)
+		}
+		if !mathutils.AlmostEqual(rate.Radius, expR, 1e-8) {
+			t.Errorf("%s: dR should be %.10f. Got: %.10f", tc.name, expR, rate.Radius)
+		}
+	}
+}
@@ -0,0 +1,122 @@
+package heliocentric
+
+import (
+	"github.com/ilbagatto/vsop87-go/coco"
+	"github.com/ilbagatto/vsop87-go/earth"
+	"github.com/ilbagatto/vsop87-go/mathutils"
+)
+
+// Reduction selects the corrections applied to a geocentric position.
+type Reduction int
+
+const (
+	// Apparent applies light-time and aberration; by default the result is
+	// referred to the ecliptic and true equinox of date.
+	Apparent Reduction = iota
+	// Astrometric applies light-time only; by default the result is
+	// referred to the mean ecliptic and equinox of J2000.
+	Astrometric
+	// Geometric gives the instantaneous position; by default it is referred
+	// to the mean ecliptic and equinox of date.
+	Geometric
+)
+
+// Frame selects the reference frame of a position.
+type Frame int
+
+const (
+	// FrameDefault is the natural frame of the reduction: J2000 for
+	// astrometric positions, of date otherwise.
+	FrameDefault Frame = iota
+	// FrameOfDate is the ecliptic and equinox of date: the true equinox
+	// (with nutation) for apparent positions, the mean one otherwise.
+	FrameOfDate
+	// FrameJ2000 is the mean ecliptic and equinox of J2000.
+	FrameJ2000
+	// FrameICRS is the ICRS, i.e. J2000 corrected for the frame bias. Its
+	// ecliptic is the ICRS equator inclined by the mean obliquity of J2000.
+	FrameICRS
+)
+
+// LightTime reports whether positions of reduction r are corrected for
+// light-time.
+func (r Reduction) LightTime() bool {
+	return r != Geometric
+}
+
+// Resolve returns the frame used by reduction r when f is FrameDefault.
+func (f Frame) Resolve(r Reduction) Frame {
+	switch {
+	case f != FrameDefault:
+		return f
+	case r == Astrometric:
+		return FrameJ2000
+	default:
+		return FrameOfDate
+	}
+}
+
+// AberrationFunc returns the aberration in longitude and latitude (radians)
+// of a light-time corrected geocentric position at Julian day jd.
+type AberrationFunc func(jd float64, pos EclCoord) (deltaLambda, deltaBeta float64)
+
+// Reduce completes reduction r of the geocentric position pos, referred to
+// the mean ecliptic and equinox of date jd, and refers the result to frame
+// f. pos must already include light-time unless r is Geometric.
+//
+// Apparent positions get aberration (nil means none). Positions of date get
+// nutation in longitude deltaPsi if apparent; the others are precessed to
+// J2000 and, for the ICRS, corrected for the frame bias.
+func Reduce(r Reduction, f Frame, jd float64, pos EclCoord, deltaPsi float64, aberration AberrationFunc) EclCoord {
+	if r == Apparent && aberration != nil {
+		dl, db := aberration(jd, pos)
+		pos.Lambda += dl
+		pos.Beta += db
+	}
+	switch f.Resolve(r) {
+	case FrameOfDate:
+		if r == Apparent {
+			pos.Lambda += deltaPsi
+		}
+		pos.Lambda = mathutils.ReduceRad(pos.Lambda)
+	case FrameJ2000:
+		pos.Lambda, pos.Beta = coco.MeanToAstrometric2000(pos.Lambda, pos.Beta, jd)
+	case FrameICRS:
+		lam, bet := coco.MeanToAstrometric2000(pos.Lambda, pos.Beta, jd)
+		ra, dec := coco.Ecl2Equ(lam, bet, earth.ObliquityJ2000)
+		ra, dec = coco.J2000ToICRS(ra, dec)
+		pos.Lambda, pos.Beta = coco.Equ2Ecl(ra, dec, earth.ObliquityJ2000)
+	}
+	return pos
+}
+
+// stateStep is the time step (days) used to carry rates through Reduce.
+const stateStep = 1.0 / 24
+
+// ReduceState is like Reduce but also carries the rates of pos (per day)
+// through the reduction. deltaPsi returns the nutation in longitude dt days
+// after jd; it may be nil unless r is Apparent and the frame is of date.
+//
+// The corrections vary slowly, so their effect on the rates is obtained by
+// differencing Reduce at positions extrapolated from the given rates, with
+// no further evaluation of the series.
+func ReduceState(r Reduction, f Frame, jd float64, pos, rate EclCoord, deltaPsi func(dt float64) float64, aberration AberrationFunc) (EclCoord, EclCoord) {
+	at := func(dt float64) EclCoord {
+		var dpsi float64
+		if deltaPsi != nil {
+			dpsi = deltaPsi(dt)
+		}
+		p := EclCoord{
+			Lambda: pos.Lambda + rate.Lambda*dt,
+			Beta:   pos.Beta + rate.Beta*dt,
+			Radius: pos.Radius + rate.Radius*dt,
+		}
+		return Reduce(r, f, jd+dt, p, dpsi, aberration)
+	}
+	p0, pp, pm := at(0), at(stateStep), at(-stateStep)
+	return p0, EclCoord{
+		Lambda: mathutils.AngNormPi(pp.Lambda-pm.Lambda) / (2 * stateStep),
+		Beta:   (pp.Beta - pm.Beta) / (2 * stateStep),
+		Radius: (pp.Radius - pm.Radius) / (2 * stateStep),
+	}
+}
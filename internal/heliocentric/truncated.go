@@ -0,0 +1,70 @@
+package heliocentric
+
+import (
+	"github.com/ilbagatto/vsop87-go/internal/vsop87"
+	"github.com/ilbagatto/vsop87-go/internal/vsop87/generated"
+)
+
+// series maps VSOP87 bodies to their L, B and R series.
+var series = map[vsop87.OrbitType][3][][]vsop87.Coeff{
+	vsop87.Mercury: {generated.Mercury_L, generated.Mercury_B, generated.Mercury_R},
+	vsop87.Venus:   {generated.Venus_L, generated.Venus_B, generated.Venus_R},
+	vsop87.Earth:   {generated.Earth_L, generated.Earth_B, generated.Earth_R},
+	vsop87.Mars:    {generated.Mars_L, generated.Mars_B, generated.Mars_R},
+	vsop87.Jupiter: {generated.Jupiter_L, generated.Jupiter_B, generated.Jupiter_R},
+	vsop87.Saturn:  {generated.Saturn_L, generated.Saturn_B, generated.Saturn_R},
+	vsop87.Uranus:  {generated.Uranus_L, generated.Uranus_B, generated.Uranus_R},
+	vsop87.Neptune: {generated.Neptune_L, generated.Neptune_B, generated.Neptune_R},
+}
+
+// seriesOf returns the L, B and R series used by body.
+func seriesOf(body Heliocentric) [3][][]vsop87.Coeff {
+	if t, ok := body.(Truncated); ok {
+		return [3][][]vsop87.Coeff{t.l, t.b, t.r}
+	}
+	return series[body.BodyType()]
+}
+
+// Truncated implements the Heliocentric interface with VSOP87 series from
+// which small terms have been dropped. It trades accuracy for speed.
+type Truncated struct {
+	body    Heliocentric
+	l, b, r [][]vsop87.Coeff
+}
+
+// NewTruncated returns a truncated model of body which keeps only the terms
+// with amplitude not less than minAmp.
+func NewTruncated(body Heliocentric, minAmp float64) Truncated {
+	s := series[body.BodyType()]
+	return Truncated{
+		body: body,
+		l:    vsop87.Truncate(s[0], minAmp),
+		b:    vsop87.Truncate(s[1], minAmp),
+		r:    vsop87.Truncate(s[2], minAmp),
+	}
+}
+
+// BodyType returns the constant identifying the planet in the VSOP87 data.
+func (t Truncated) BodyType() vsop87.OrbitType {
+	return t.body.BodyType()
+}
+
+// Name returns the human-readable name of the planet.
+func (t Truncated) Name() string {
+	return t.body.Name()
+}
+
+// Longitude computes the ecliptic longitude L(t) from the truncated L-series.
+func (t Truncated) Longitude(tau float64) float64 {
+	return vsop87.ComputeSeries(tau, t.l)
+}
+
+// Latitude computes the ecliptic latitude B(t) from the truncated B-series.
+func (t Truncated) Latitude(tau float64) float64 {
+	return vsop87.ComputeSeries(tau, t.b)
+}
+
+// RadiusVector computes the radius vector R(t) from the truncated R-series.
+func (t Truncated) RadiusVector(tau float64) float64 {
+	return vsop87.ComputeSeries(tau, t.r)
+}
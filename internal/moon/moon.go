@@ -197,6 +197,28 @@ func getCoeff(m int, c int, E float64) float64 {
 // Apparent computes the Moon's apparent geocentric ecliptic coordinates.
 // Returns Ecliptical coordinates.
 func Apparent(jd, deltaPsi float64) heliocentric.EclCoord {
+	return Position(jd, heliocentric.Apparent, heliocentric.FrameOfDate, deltaPsi)
+}
+
+// Position returns geocentric ecliptic coordinates of the Moon at jd,
+// reduced as red and referred to frame; deltaPsi is used only by apparent
+// positions of date.
+//
+// The series already include light-time, and the aberration due to Earth's
+// motion does not apply to a body travelling with it, so apparent positions
+// need nutation only. Geometric ones are taken from the series one
+// light-time later.
+func Position(jd float64, red heliocentric.Reduction, frame heliocentric.Frame, deltaPsi float64) heliocentric.EclCoord {
+	pos := series(jd)
+	if !red.LightTime() {
+		pos = series(jd + pos.Radius*heliocentric.LightTimeDaysPerAU)
+	}
+	return heliocentric.Reduce(red, frame, jd, pos, deltaPsi, nil)
+}
+
+// series computes the Moon's geocentric ecliptic coordinates, referred to
+// the mean equinox of date (Meeus, ch. 47).
+func series(jd float64) heliocentric.EclCoord {
 	// centuries since J2000
 	t := (jd - timeutils.J2000) / timeutils.DaysPerCent
 
@@ -247,10 +269,9 @@ func Apparent(jd, deltaPsi float64) heliocentric.EclCoord {
 	el += 3958*math.Sin(a[0]) + 1962*math.Sin(l-f) + 318*math.Sin(a[1])
 	eb += -2235*math.Sin(l) + 382*math.Sin(a[2]) + 175*math.Sin(a[0]-f) + 175*math.Sin(a[0]+f) + 127*math.Sin(l-m) - 115*math.Sin(l+m)
 
-	// Final assembly (angles from degrees → radians, + nutation)
-	// convert to radians, add nutation
+	// Final assembly (angles from degrees → radians)
 	return heliocentric.EclCoord{
-		Lambda: mathutils.ReduceRad(mathutils.Radians(L+el/1e6) + deltaPsi),
+		Lambda: mathutils.ReduceRad(mathutils.Radians(L + el/1e6)),
 		Beta:   mathutils.Radians(eb / 1e6),       // latitude in radians
 		Radius: utils.KmToAU(385000.56 + er/1000), // distance in AU
 	}
@@ -3,6 +3,7 @@ package moon
 import (
 	"testing"
 
+	"github.com/ilbagatto/vsop87-go/internal/heliocentric"
 	"github.com/ilbagatto/vsop87-go/mathutils"
 	"github.com/ilbagatto/vsop87-go/utils"
 )
@@ -39,3 +40,36 @@ func TestParallax(t *testing.T) {
 		t.Errorf("Parallax should be %.5f. Got: %.5f", exp, got)
 	}
 }
+
+func TestGeometricMoon(t *testing.T) {
+	// light-time displaces the Moon by about 0.7″ in longitude
+	const jd = 2448724.5
+	apparent := Position(jd, heliocentric.Apparent, heliocentric.FrameOfDate, 0)
+	geometric := Position(jd, heliocentric.Geometric, heliocentric.FrameOfDate, 0)
+
+	got := mathutils.Degrees(geometric.Lambda-apparent.Lambda) * 3600
+	if got < 0.5 || got > 0.9 {
+		t.Errorf("Light-time displacement should be about 0.7″. Got: %.3f″", got)
+	}
+}
+
+func TestRates(t *testing.T) {
+	const jd = 2448724.5
+	const h = 1.0 / 144 // 10 minutes
+	p := series(jd + h)
+	m := series(jd - h)
+	expL := mathutils.AngNormPi(p.Lambda-m.Lambda) / (2 * h)
+	expB := (p.Beta - m.Beta) / (2 * h)
+	expR := (p.Radius - m.Radius) / (2 * h)
+
+	gotL, gotB, gotR := Rates(jd)
+	if !mathutils.AlmostEqual(gotL, expL, 5e-8) {
+		t.Errorf("Longitude rate should be %.10f. Got: %.10f", expL, gotL)
+	}
+	if !mathutils.AlmostEqual(gotB, expB, 5e-8) {
+		t.Errorf("Latitude rate should be %.10f. Got: %.10f", expB, gotB)
+	}
+	if !mathutils.AlmostEqual(gotR, expR, 1e-11) {
+		t.Errorf("Distance rate should be %.13f. Got: %.13f", expR, gotR)
+	}
+}
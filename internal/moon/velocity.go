@@ -5,6 +5,7 @@ import (
 
 	"github.com/ilbagatto/vsop87-go/mathutils"
 	"github.com/ilbagatto/vsop87-go/timeutils"
+	"github.com/ilbagatto/vsop87-go/utils"
 )
 
 // LongitudinalSpeedMeeus returns the Moon's geocentric angular speed in
@@ -95,3 +96,93 @@ func AngularSpeed(jdTT float64, relativeToSun bool) float64 {
 	}
 	return sum
 }
+
+// Rates returns the rates of change of the Moon's geocentric ecliptic
+// longitude, latitude (radians/day) and distance (AU/day) at jdTT, obtained
+// by differentiating the series of Meeus, ch. 47, term by term.
+func Rates(jdTT float64) (dLambda, dBeta, dRadius float64) {
+	t := (jdTT - timeutils.J2000) / timeutils.DaysPerCent
+
+	// mean arguments (radians) and their rates (radians/century)
+	mean := make([]float64, 4)
+	rate := make([]float64, 4)
+	for i, k := range []string{"D", "MS", "M", "F"} {
+		coeffs := mooOrbit[k]
+		if k == "MS" {
+			coeffs = sunOrbit["M"]
+		}
+		mean[i] = mathutils.Radians(mathutils.Polynome(t, coeffs...))
+		rate[i] = mathutils.Radians(polynomeRate(t, coeffs...))
+	}
+	L := mathutils.Radians(mathutils.Polynome(t, mooOrbit["L"]...))
+	dL := mathutils.Radians(polynomeRate(t, mooOrbit["L"]...))
+	M, dM := mean[2], rate[2]
+	F, dF := mean[3], rate[3]
+
+	// eccentricity factor E and its rate
+	E := mathutils.Polynome(t, 1, -0.002516, -0.0000074)
+	dE := polynomeRate(t, 1, -0.002516, -0.0000074)
+
+	// d/dt of c·E^k·sin(arg) and of c·E^k·cos(arg)
+	var del, der, deb float64
+	for _, term := range lrTerms {
+		arg := argSum(term.D, term.MS, term.M, term.F, mean)
+		dArg := argSum(term.D, term.MS, term.M, term.F, rate)
+		sin, cos := math.Sincos(arg)
+		cl, dcl := coeffRate(term.MS, term.SL, E, dE)
+		cr, dcr := coeffRate(term.MS, term.SR, E, dE)
+		del += cl*cos*dArg + dcl*sin
+		der += -cr*sin*dArg + dcr*cos
+	}
+	for _, term := range bTerms {
+		arg := argSum(term.D, term.MS, term.M, term.F, mean)
+		dArg := argSum(term.D, term.MS, term.M, term.F, rate)
+		sin, cos := math.Sincos(arg)
+		cb, dcb := coeffRate(term.MS, term.SB, E, dE)
+		deb += cb*cos*dArg + dcb*sin
+	}
+
+	// additive terms (Meeus §47)
+	a := make([]float64, len(aTerms))
+	da := make([]float64, len(aTerms))
+	for i, at := range aTerms {
+		a[i] = mathutils.Radians(mathutils.Polynome(t, at.A, at.B))
+		da[i] = mathutils.Radians(at.B)
+	}
+	del += 3958*math.Cos(a[0])*da[0] + 1962*math.Cos(L-F)*(dL-dF) + 318*math.Cos(a[1])*da[1]
+	deb += -2235*math.Cos(L)*dL + 382*math.Cos(a[2])*da[2] +
+		175*math.Cos(a[0]-F)*(da[0]-dF) + 175*math.Cos(a[0]+F)*(da[0]+dF) +
+		127*math.Cos(L-M)*(dL-dM) - 115*math.Cos(L+M)*(dL+dM)
+
+	// per century → per day; series units are 1e-6 degree and 1e-3 km
+	dLambda = (dL + mathutils.Radians(del/1e6)) / timeutils.DaysPerCent
+	dBeta = mathutils.Radians(deb/1e6) / timeutils.DaysPerCent
+	dRadius = utils.KmToAU(der/1000) / timeutils.DaysPerCent
+	return
+}
+
+// coeffRate returns the coefficient c·E^|ms| of a periodic term, as applied
+// by getCoeff, and its rate given the rate dE of E.
+func coeffRate(ms, c int, E, dE float64) (coeff, rate float64) {
+	fc := float64(c)
+	switch ms {
+	case 0:
+		return fc, 0
+	case 1, -1:
+		return fc * E, fc * dE
+	default:
+		return fc * E * E, 2 * fc * E * dE
+	}
+}
+
+// polynomeRate returns the derivative of mathutils.Polynome(t, terms...)
+// with respect to t.
+func polynomeRate(t float64, terms ...float64) float64 {
+	res := 0.0
+	power := 1.0
+	for i := 1; i < len(terms); i++ {
+		res += float64(i) * terms[i] * power
+		power *= t
+	}
+	return res
+}
@@ -0,0 +1,144 @@
+package physical
+
+import (
+	"math"
+
+	"github.com/ilbagatto/vsop87-go/coco"
+	"github.com/ilbagatto/vsop87-go/internal/heliocentric"
+	"github.com/ilbagatto/vsop87-go/mathutils"
+	"github.com/ilbagatto/vsop87-go/timeutils"
+)
+
+// Geometry holds the positions of a planet and the Earth needed for the
+// physical ephemeris of the planet.
+type Geometry struct {
+	JD         float64 // Julian Day (TT)
+	L, B, R    float64 // heliocentric coordinates of the planet when the light left it, of date
+	L0, B0, R0 float64 // heliocentric coordinates of the Earth, of date
+	Eps0       float64 // mean obliquity of the ecliptic
+	DeltaPsi   float64 // nutation in longitude
+	DeltaEps   float64 // nutation in obliquity
+}
+
+// Disk describes the orientation of the disk of a planet (Meeus, ch. 42–43).
+// All angles are in radians.
+type Disk struct {
+	DE  float64 // planetocentric declination of the Earth
+	DS  float64 // planetocentric declination of the Sun
+	P   float64 // position angle of the north pole, from the north through the east
+	CM1 float64 // longitude of the central meridian (System I for Jupiter)
+	CM2 float64 // longitude of the central meridian in System II (Jupiter only)
+}
+
+// MarsDisk returns the physical ephemeris of Mars (Meeus, ch. 42).
+func MarsDisk(g Geometry) Disk {
+	t := (g.JD - timeutils.J2000) / timeutils.DaysPerCent
+	// north pole of Mars, ecliptic of date
+	lam0 := mathutils.Radians(352.9065 + 1.17330*t)
+	beta0 := mathutils.Radians(63.2818 - 0.00394*t)
+
+	// Sun seen from Mars, corrected for aberration
+	node := mathutils.Radians(49.5581 + 0.7721*t)
+	l := g.L - mathutils.Radians(0.00697)/g.R
+	b := g.B - mathutils.Radians(0.000225)*math.Cos(g.L-node)/g.R
+
+	alpha0, delta0 := coco.Ecl2Equ(lam0, beta0, g.Eps0)
+	alphaS, deltaS := coco.Ecl2Equ(l, b, g.Eps0)
+	d, xi := orientation(g, alpha0, delta0, alphaS, deltaS)
+	w := rotation(g, 11.504, 350.89200025)
+	d.CM1 = mathutils.ReduceRad(w - xi)
+	return d
+}
+
+// JupiterDisk returns the physical ephemeris of Jupiter (Meeus, ch. 43,
+// second method). The central meridians are corrected for phase, so that
+// they refer to the centre of the illuminated disk.
+func JupiterDisk(g Geometry) Disk {
+	t1 := (g.JD - 2433282.5) / timeutils.DaysPerCent
+	// north pole of Jupiter, equator and equinox of date
+	alpha0 := mathutils.Radians(268.00 + 0.1061*t1)
+	delta0 := mathutils.Radians(64.50 - 0.0164*t1)
+
+	// Sun seen from Jupiter, corrected for aberration on a circular orbit
+	l := g.L - mathutils.Radians(0.005693)/math.Sqrt(g.R)
+	alphaS, deltaS := coco.Ecl2Equ(l, g.B, g.Eps0)
+	d, xi := orientation(g, alpha0, delta0, alphaS, deltaS)
+
+	// correction for phase
+	x, y, z := geocentric(g)
+	delta := math.Sqrt(x*x + y*y + z*z)
+	c := (2*g.R*delta + g.R0*g.R0 - g.R*g.R - delta*delta) / (4 * g.R * delta)
+	if math.Sin(g.L-g.L0) < 0 {
+		c = -c
+	}
+	d.CM1 = mathutils.ReduceRad(rotation(g, 17.710, 877.90003539) - xi + c)
+	d.CM2 = mathutils.ReduceRad(rotation(g, 16.838, 870.27003539) - xi + c)
+	return d
+}
+
+// orientation returns the planetocentric declinations of the Earth and the
+// Sun, the position angle of the pole and the angle ξ between the prime
+// meridian's node and the central meridian. alpha0, delta0 is the north
+// pole and alphaS, deltaS the heliocentric direction of the planet, both
+// referred to the mean equator and equinox of date.
+func orientation(g Geometry, alpha0, delta0, alphaS, deltaS float64) (Disk, float64) {
+	x, y, z := geocentric(g)
+	sinE, cosE := math.Sincos(g.Eps0)
+	u := y*cosE - z*sinE
+	v := y*sinE + z*cosE
+	alpha := math.Atan2(u, x)
+	delta := math.Atan2(v, math.Hypot(x, u))
+
+	var d Disk
+	d.DE = declination(alpha0, delta0, alpha, delta)
+	d.DS = declination(alpha0, delta0, alphaS, deltaS)
+
+	sinD0, cosD0 := math.Sincos(delta0)
+	sinD, cosD := math.Sincos(delta)
+	xi := math.Atan2(sinD0*cosD*math.Cos(alpha0-alpha)-sinD*cosD0, cosD*math.Sin(alpha0-alpha))
+
+	// apparent place: aberration and nutation
+	lam := math.Atan2(y, x)
+	beta := math.Atan2(z, math.Hypot(x, y))
+	k := mathutils.Radians(0.005693)
+	lam, beta = lam+k*math.Cos(g.L0-lam)/math.Cos(beta), beta+k*math.Sin(g.L0-lam)*math.Sin(beta)
+	eps := g.Eps0 + g.DeltaEps
+	alphaA, deltaA := coco.Ecl2Equ(lam+g.DeltaPsi, beta, eps)
+	lam0, beta0 := coco.Equ2Ecl(alpha0, delta0, g.Eps0)
+	alpha0A, delta0A := coco.Ecl2Equ(lam0+g.DeltaPsi, beta0, eps)
+
+	sinD0, cosD0 = math.Sincos(delta0A)
+	sinD, cosD = math.Sincos(deltaA)
+	d.P = mathutils.ReduceRad(math.Atan2(
+		cosD0*math.Sin(alpha0A-alphaA),
+		sinD0*cosD-cosD0*sinD*math.Cos(alpha0A-alphaA)))
+	return d, xi
+}
+
+// declination returns the planetocentric declination of an observer who
+// sees the planet in the direction alpha, delta, for the pole at alpha0,
+// delta0.
+func declination(alpha0, delta0, alpha, delta float64) float64 {
+	sinD0, cosD0 := math.Sincos(delta0)
+	sinD, cosD := math.Sincos(delta)
+	return math.Asin(clamp(-sinD0*sinD - cosD0*cosD*math.Cos(alpha0-alpha)))
+}
+
+// geocentric returns the rectangular ecliptic coordinates of the planet
+// referred to the Earth, AU.
+func geocentric(g Geometry) (x, y, z float64) {
+	sinB, cosB := math.Sincos(g.B)
+	sinB0, cosB0 := math.Sincos(g.B0)
+	x = g.R*cosB*math.Cos(g.L) - g.R0*cosB0*math.Cos(g.L0)
+	y = g.R*cosB*math.Sin(g.L) - g.R0*cosB0*math.Sin(g.L0)
+	z = g.R*sinB - g.R0*sinB0
+	return x, y, z
+}
+
+// rotation returns the angle of the prime meridian W = w0 + rate·d (degrees,
+// d counted from 1950 January 0.0 TT) at the time the light left the planet.
+func rotation(g Geometry, w0, rate float64) float64 {
+	x, y, z := geocentric(g)
+	tau := math.Sqrt(x*x+y*y+z*z) * heliocentric.LightTimeDaysPerAU
+	return mathutils.Radians(mathutils.ReduceDeg(w0 + rate*(g.JD-tau-2433282.5)))
+}
@@ -0,0 +1,70 @@
+// Package physical implements the physical ephemerides of the planets:
+// phase, brightness, the rings of Saturn and the orientation of the disks
+// (Meeus, ch. 41–45).
+package physical
+
+import (
+	"math"
+
+	"github.com/ilbagatto/vsop87-go/mathutils"
+)
+
+// MagnitudeCoeffs are the coefficients of the visual magnitude of a planet
+// (Astronomical Almanac 1984, Meeus ch. 41):
+//
+//	m = V0 + 5·log10(r·Δ) + A1·i + A2·i² + A3·i³
+//
+// where i is the phase angle in degrees.
+type MagnitudeCoeffs struct {
+	V0, A1, A2, A3 float64
+}
+
+var (
+	Mercury = MagnitudeCoeffs{V0: -0.42, A1: 0.0380, A2: -0.000273, A3: 0.000002}
+	Venus   = MagnitudeCoeffs{V0: -4.40, A1: 0.0009, A2: 0.000239, A3: -0.00000065}
+	Mars    = MagnitudeCoeffs{V0: -1.52, A1: 0.016}
+	Jupiter = MagnitudeCoeffs{V0: -9.40, A1: 0.005}
+	Uranus  = MagnitudeCoeffs{V0: -7.19}
+	Neptune = MagnitudeCoeffs{V0: -6.87}
+	Pluto   = MagnitudeCoeffs{V0: -1.00}
+)
+
+// PhaseAngle returns the Sun–planet–Earth angle (radians) from the
+// heliocentric distance r, the geocentric distance delta and the distance
+// of the Earth from the Sun R, all in AU (Meeus 41.3).
+func PhaseAngle(r, delta, R float64) float64 {
+	return math.Acos(clamp((r*r + delta*delta - R*R) / (2 * r * delta)))
+}
+
+// Elongation returns the Sun–Earth–planet angle (radians) from the same
+// distances.
+func Elongation(r, delta, R float64) float64 {
+	return math.Acos(clamp((R*R + delta*delta - r*r) / (2 * R * delta)))
+}
+
+// Illuminated returns the illuminated fraction of the disk for the phase
+// angle i (Meeus 41.1).
+func Illuminated(i float64) float64 {
+	return (1 + math.Cos(i)) / 2
+}
+
+// Magnitude returns the visual magnitude of a planet at heliocentric
+// distance r and geocentric distance delta (AU) for the phase angle i
+// (radians).
+func Magnitude(c MagnitudeCoeffs, r, delta, i float64) float64 {
+	deg := mathutils.Degrees(i)
+	return c.V0 + 5*math.Log10(r*delta) + mathutils.Polynome(deg, 0, c.A1, c.A2, c.A3)
+}
+
+// SaturnMagnitude returns the visual magnitude of Saturn with its rings.
+// b is the Saturnicentric latitude of the Earth referred to the plane of the
+// rings and du the difference between the Saturnicentric longitudes of the
+// Sun and the Earth, both radians (see SaturnRing).
+func SaturnMagnitude(r, delta, b, du float64) float64 {
+	sinB := math.Sin(math.Abs(b))
+	return -8.88 + 5*math.Log10(r*delta) + 0.044*math.Abs(mathutils.Degrees(du)) - 2.60*sinB + 1.25*sinB*sinB
+}
+
+func clamp(x float64) float64 {
+	return math.Max(-1, math.Min(1, x))
+}
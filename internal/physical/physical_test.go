@@ -0,0 +1,28 @@
+package physical
+
+import (
+	"testing"
+
+	"github.com/ilbagatto/vsop87-go/mathutils"
+)
+
+func TestMagnitude(t *testing.T) {
+	// Meeus, example 41.a: Venus, 1992 December 20
+	const r, delta, R = 0.724604, 0.910947, 0.983824
+	i := PhaseAngle(r, delta, R)
+	if exp := 72.96; !mathutils.AlmostEqual(mathutils.Degrees(i), exp, 0.01) {
+		t.Errorf("Phase angle should be %.2f. Got: %.2f", exp, mathutils.Degrees(i))
+	}
+	if exp := 0.647; !mathutils.AlmostEqual(Illuminated(i), exp, 1e-3) {
+		t.Errorf("Illuminated fraction should be %.3f. Got: %.3f", exp, Illuminated(i))
+	}
+	// Meeus gives −3.8 with Müller's formula; the 1984 Almanac one yields −4.2
+	if exp := -4.217; !mathutils.AlmostEqual(Magnitude(Venus, r, delta, i), exp, 1e-3) {
+		t.Errorf("Magnitude should be %.3f. Got: %.3f", exp, Magnitude(Venus, r, delta, i))
+	}
+	// Meeus, example 41.b: Saturn, 1992 December 16 (+0.9 with Müller's formula)
+	got := SaturnMagnitude(9.867882, 10.464606, mathutils.Radians(16.442), mathutils.Radians(4.198))
+	if exp := 0.739; !mathutils.AlmostEqual(got, exp, 1e-3) {
+		t.Errorf("Saturn's magnitude should be %.3f. Got: %.3f", exp, got)
+	}
+}
@@ -0,0 +1,37 @@
+package physical
+
+import (
+	"math"
+
+	"github.com/ilbagatto/vsop87-go/mathutils"
+	"github.com/ilbagatto/vsop87-go/timeutils"
+)
+
+// SaturnRing returns the Saturnicentric latitude of the Earth b, referred to
+// the plane of the rings, and the difference du between the Saturnicentric
+// longitudes of the Sun and the Earth measured in that plane (Meeus, ch. 45).
+//
+//	jd          : Julian Day (TT)
+//	lambda, beta: geocentric ecliptic longitude and latitude of Saturn, of date
+//	l, b0, r    : heliocentric longitude, latitude (radians) and distance (AU)
+//	              of Saturn at the time the light left it
+func SaturnRing(jd, lambda, beta, l, b0, r float64) (b, du float64) {
+	t := (jd - timeutils.J2000) / timeutils.DaysPerCent
+	// inclination and ascending node of the plane of the ring
+	i := mathutils.Radians(mathutils.Polynome(t, 28.075216, -0.012998, 0.000004))
+	node := mathutils.Radians(mathutils.Polynome(t, 169.508470, 1.394681, 0.000412))
+	sinI, cosI := math.Sincos(i)
+
+	sinBeta, cosBeta := math.Sincos(beta)
+	b = math.Asin(sinI*cosBeta*math.Sin(lambda-node) - cosI*sinBeta)
+
+	// correct the heliocentric position for the aberration of the Sun seen from Saturn
+	l0 := l - mathutils.Radians(0.01759)/r
+	b1 := b0 - mathutils.Radians(0.000764)*math.Cos(l-node)/r
+	sinB1, cosB1 := math.Sincos(b1)
+
+	u1 := math.Atan2(sinI*sinB1+cosI*cosB1*math.Sin(l0-node), cosB1*math.Cos(l0-node))
+	u2 := math.Atan2(sinI*sinBeta+cosI*cosBeta*math.Sin(lambda-node), cosBeta*math.Cos(lambda-node))
+	du = math.Abs(math.Remainder(u1-u2, 2*math.Pi))
+	return b, du
+}
@@ -114,22 +114,23 @@ func sphericalHelio(jd float64) mathutils.Spherical {
 }
 
 // GeocentricEQ computes Pluto’s geocentric equatorial coordinates (RA, Dec)
-// and distance (AU) for a given Julian Day jd. It iteratively corrects for
-// light-time, mirroring the Python version.
+// and distance (AU) for a given Julian Day jd, referred to J2000. sunPos is
+// the geocentric position of the Sun, as returned by sun.Rect2000. If
+// lightTime is set, it iteratively corrects for light-time, mirroring the
+// Python version.
 //
 // Returns:
 //
 //	alpha (right ascension, radians),
 //	delta (declination,    radians),
 //	dist  (distance,        AU).
-func geocentricEQ(jd float64) (alpha, delta, dist float64) {
-	// 1) Sun’s heliocentric rectangular coords (AU) in J2000 frame.
-	sunPos := sun.Rect2000(jd)
+func geocentricEQ(jd float64, sunPos mathutils.Point3D, lightTime bool) (alpha, delta, dist float64) {
+	// 1) Sun’s geocentric rectangular coords (AU) in J2000 frame.
 	xs, ys, zs := sunPos.X, sunPos.Y, sunPos.Z
 
 	// 2) Prepare for light-time iteration.
 	jdCorr := jd
-	firstPass := true
+	firstPass := lightTime
 
 	// 4) Iterate until light-time correction converges.
 	for {
@@ -166,24 +167,52 @@ func geocentricEQ(jd float64) (alpha, delta, dist float64) {
 	return alpha, delta, dist
 }
 
-// Geocentric returns Pluto’s apparent geocentric ecliptic coordinates at jd.
+// Apparent returns Pluto’s apparent geocentric ecliptic coordinates at jd.
 //
 //	λ  = longitude (radians, reduced to [0,2π))
 //	β  = latitude  (radians)
 //	Δ  = distance  (AU)
 func Apparent(jd float64, deltaPsi float64) heliocentric.EclCoord {
+	return Position(jd, heliocentric.Apparent, heliocentric.FrameOfDate, deltaPsi)
+}
+
+// Position returns Pluto’s geocentric ecliptic coordinates at jd, reduced
+// as red and referred to frame; deltaPsi is used only by apparent positions
+// of date.
+func Position(jd float64, red heliocentric.Reduction, frame heliocentric.Frame, deltaPsi float64) heliocentric.EclCoord {
 	// 1) get geocentric equatorial coords and Earth‐Pluto distance:
-	alpha, delta, dist := geocentricEQ(jd)
+	sunPos := sun.Rect2000(jd)
+	alpha, delta, dist := geocentricEQ(jd, sunPos, red.LightTime())
 
 	// 2) convert equatorial → ecliptic (J2000)
 	lam0, bet0 := coco.Transform(alpha, delta, sinE, cosE, coco.EquToEcl)
+	if red != heliocentric.Apparent && frame.Resolve(red) == heliocentric.FrameJ2000 {
+		// the model is already referred to J2000
+		return heliocentric.EclCoord{Lambda: lam0, Beta: bet0, Radius: dist}
+	}
 
 	// 3) precess from J2000 → mean equinox of date
 	lam1, bet1 := coco.Astrometric2000ToMean(lam0, bet0, jd)
+	pos := heliocentric.EclCoord{Lambda: lam1, Beta: bet1, Radius: dist}
 
-	return heliocentric.EclCoord{
-		Lambda: mathutils.ReduceRad(lam1 + deltaPsi),
-		Beta:   bet1,
-		Radius: dist,
-	}
+	return heliocentric.Reduce(red, frame, jd, pos, deltaPsi, func(_ float64, pos heliocentric.EclCoord) (float64, float64) {
+		return heliocentric.Aberration(jd, pos.Lambda, pos.Beta, sunLongitude(jd, sunPos))
+	})
+}
+
+// LightTimeLBR returns Pluto's heliocentric coordinates, referred to the
+// ecliptic and equinox of J2000, at the moment the light reaching the Earth
+// at jd left it, and the geocentric distance (AU) travelled by that light.
+func LightTimeLBR(jd float64) (helio mathutils.Spherical, delta float64) {
+	_, _, delta = geocentricEQ(jd, sun.Rect2000(jd), true)
+	return sphericalHelio(jd - delta*heliocentric.LightTimeDaysPerAU), delta
+}
+
+// sunLongitude returns the geometric longitude of the Sun, referred to the
+// mean equinox of date, from its geocentric J2000 position sunPos.
+func sunLongitude(jd float64, sunPos mathutils.Point3D) float64 {
+	sph := sunPos.ToSpherical()
+	lam0, bet0 := coco.Transform(sph.Phi, sph.Theta, sinE, cosE, coco.EquToEcl)
+	lam, _ := coco.Astrometric2000ToMean(lam0, bet0, jd)
+	return lam
 }
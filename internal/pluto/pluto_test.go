@@ -3,6 +3,9 @@ package pluto
 import (
 	"testing"
 
+	"github.com/ilbagatto/vsop87-go/coco"
+	"github.com/ilbagatto/vsop87-go/internal/heliocentric"
+	"github.com/ilbagatto/vsop87-go/internal/sun"
 	"github.com/ilbagatto/vsop87-go/mathutils"
 )
 
@@ -38,7 +41,7 @@ func TestGeocentricEQ(t *testing.T) {
 	expD := -4.45802
 	expR := 30.528739
 
-	gotA, gotD, gotR := geocentricEQ(jd)
+	gotA, gotD, gotR := geocentricEQ(jd, sun.Rect2000(jd), true)
 	gotA = mathutils.Degrees(gotA)
 	gotD = mathutils.Degrees(gotD)
 
@@ -55,22 +58,45 @@ func TestGeocentricEQ(t *testing.T) {
 }
 
 func TestApparent(t *testing.T) {
-	const threshold = 1e-6
+	// The apparent position now includes the annual aberration; formerly
+	// only precession and nutation were applied. The reference is derived
+	// from the astrometric place of Meeus, example 37.a (α = 232.93231°,
+	// δ = −4.45802°, Δ = 30.528739): ecliptic J2000 with ε = 23.4392911°,
+	// precessed to date by Meeus 21.5 (231.594259°, 14.189775°), annual
+	// aberration by Meeus 23.2 (Δλ = −18.21″, Δβ = +2.57″) and the nutation
+	// passed below.
+	const threshold = 1e-4 // 0.36″
 	got := Apparent(jd, mathutils.Radians(0.004450323252274867))
 	gotLambda := mathutils.Degrees(got.Lambda)
 	gotBeta := mathutils.Degrees(got.Beta)
 
-	expLambda := 231.59870478601076
-	expBeta := 14.189772216809267
-	expRadius := 30.528739583573994
+	expLambda := 231.59365
+	expBeta := 14.19049
+	expRadius := 30.528739
 
 	if !mathutils.AlmostEqual(gotLambda, expLambda, threshold) {
-		t.Errorf("Lambda should be %.6f. Got: %.6f", expLambda, gotLambda)
+		t.Errorf("Lambda should be %.5f. Got: %.5f", expLambda, gotLambda)
 	}
 	if !mathutils.AlmostEqual(gotBeta, expBeta, threshold) {
-		t.Errorf("Beta should be %.6f. Got: %.6f", expBeta, gotBeta)
+		t.Errorf("Beta should be %.5f. Got: %.5f", expBeta, gotBeta)
 	}
-	if !mathutils.AlmostEqual(got.Radius, expRadius, threshold) {
+	if !mathutils.AlmostEqual(got.Radius, expRadius, 1e-5) {
 		t.Errorf("Radius should be %.6f. Got: %.6f", expRadius, got.Radius)
 	}
 }
+
+func TestAstrometric(t *testing.T) {
+	// Meeus, example 37.a: astrometric position referred to J2000
+	const threshold = 1e-4
+	got := Position(jd, heliocentric.Astrometric, heliocentric.FrameDefault, 0)
+	gotA, gotD := coco.Transform(got.Lambda, got.Beta, sinE, cosE, coco.EclToEqu)
+	gotA = mathutils.Degrees(gotA)
+	gotD = mathutils.Degrees(gotD)
+
+	if !mathutils.AlmostEqual(gotA, 232.93231, threshold) {
+		t.Errorf("Alpha should be %.4f. Got: %.4f", 232.93231, gotA)
+	}
+	if !mathutils.AlmostEqual(gotD, -4.45802, threshold) {
+		t.Errorf("Delta should be %.4f. Got: %.4f", -4.45802, gotD)
+	}
+}
@@ -0,0 +1,90 @@
+package search
+
+import (
+	"cmp"
+	"math"
+	"slices"
+)
+
+// Crossing is a moment when an angle passes a target value.
+type Crossing struct {
+	JD         float64
+	Target     float64 // target angle, radians
+	Increasing bool    // the angle increases through the target
+}
+
+// Grid returns sampling times from start to end, no more than step apart.
+// The breaks inside the range, e.g. moments when the sampled angle changes
+// direction, are included, so that the angle is monotonic between
+// neighbouring samples if it is monotonic between breaks.
+func Grid(start, end, step float64, breaks ...float64) []float64 {
+	bounds := []float64{start}
+	for _, b := range breaks {
+		if b > start && b < end {
+			bounds = append(bounds, b)
+		}
+	}
+	slices.Sort(bounds)
+	bounds = append(bounds, end)
+
+	times := []float64{start}
+	for i := 1; i < len(bounds); i++ {
+		a, b := bounds[i-1], bounds[i]
+		n := math.Max(1, math.Ceil((b-a)/step))
+		h := (b - a) / n
+		for k := 1.0; k < n; k++ {
+			times = append(times, a+k*h)
+		}
+		times = append(times, b)
+	}
+	return times
+}
+
+// AngleCrossings returns the moments when the angle f (radians) passes any
+// of the targets, in chronological order. f is sampled at the given times,
+// and each crossing is refined to within tol days. The angle must change by
+// less than π between neighbouring samples, and it can pass a target at most
+// once between them.
+func AngleCrossings(f Func, targets, times []float64, tol float64) ([]Crossing, error) {
+	if len(times) == 0 {
+		return nil, nil
+	}
+	var res []Crossing
+	a := times[0]
+	fa, err := f(a)
+	if err != nil {
+		return nil, err
+	}
+	for _, b := range times[1:] {
+		fb, err := f(b)
+		if err != nil {
+			return nil, err
+		}
+		for _, t := range targets {
+			da, db := diff(fa, t), diff(fb, t)
+			// the difference jumps by 2π opposite the target
+			if !changesSign(da, db) || math.Abs(db-da) >= math.Pi {
+				continue
+			}
+			g := func(jd float64) (float64, error) {
+				x, err := f(jd)
+				return diff(x, t), err
+			}
+			x, err := Refine(g, a, da, b, db, tol)
+			if err != nil {
+				return nil, err
+			}
+			res = append(res, Crossing{JD: x, Target: t, Increasing: da < db})
+		}
+		a, fa = b, fb
+	}
+	slices.SortFunc(res, func(x, y Crossing) int {
+		return cmp.Compare(x.JD, y.JD)
+	})
+	return res, nil
+}
+
+// diff returns the angle x − t normalized to [−π, π].
+func diff(x, t float64) float64 {
+	return math.Remainder(x-t, 2*math.Pi)
+}
@@ -0,0 +1,107 @@
+// Package search finds the moments when a function of time crosses zero
+// or reaches an extremum.
+package search
+
+import "math"
+
+// maxIter limits the number of refinement steps for a single root.
+const maxIter = 100
+
+// Func is a function of time (Julian Day).
+type Func func(jd float64) (float64, error)
+
+// Root is a zero of a function.
+type Root struct {
+	JD     float64
+	Rising bool // the function goes from negative to positive
+}
+
+// Roots returns all zeros of f in [start, end], in chronological order.
+// f is sampled every step days and each change of sign is refined to within
+// tol days, so zeros closer than step to each other may be missed.
+//
+// If maxJump is positive, a change of sign across which |f| changes by more
+// than maxJump is taken for a discontinuity, e.g. an angle wrapping from π
+// to −π, and ignored.
+func Roots(f Func, start, end, step, tol, maxJump float64) ([]Root, error) {
+	var roots []Root
+	a := start
+	fa, err := f(a)
+	if err != nil {
+		return nil, err
+	}
+	for a < end {
+		b := math.Min(a+step, end)
+		fb, err := f(b)
+		if err != nil {
+			return nil, err
+		}
+		if changesSign(fa, fb) && (maxJump <= 0 || math.Abs(fb-fa) <= maxJump) {
+			x, err := Refine(f, a, fa, b, fb, tol)
+			if err != nil {
+				return nil, err
+			}
+			// a zero at the boundary between two intervals is reported once
+			if n := len(roots); n == 0 || math.Abs(roots[n-1].JD-x) > tol {
+				roots = append(roots, Root{JD: x, Rising: fa < fb})
+			}
+		}
+		a, fa = b, fb
+	}
+	return roots, nil
+}
+
+// Refine finds a zero of f in [a, b], where fa = f(a) and fb = f(b) have
+// opposite signs (or one of them is zero), to within tol days. It uses the
+// Illinois variant of regula falsi, which never leaves the bracket.
+func Refine(f Func, a, fa, b, fb, tol float64) (float64, error) {
+	if fa == 0 {
+		return a, nil
+	}
+	if fb == 0 {
+		return b, nil
+	}
+	c := a
+	for range maxIter {
+		prev := c
+		c = (a*fb - b*fa) / (fb - fa)
+		fc, err := f(c)
+		if err != nil {
+			return 0, err
+		}
+		if fc == 0 || math.Abs(c-prev) < tol || math.Abs(b-a) < tol {
+			return c, nil
+		}
+		if changesSign(fb, fc) {
+			a, fa = b, fb
+		} else {
+			// the same end is retained twice: halve its weight
+			fa /= 2
+		}
+		b, fb = c, fc
+	}
+	return c, nil
+}
+
+// changesSign reports whether x and y have opposite signs or y is zero
+// while x is not.
+func changesSign(x, y float64) bool {
+	return (x < 0 && y >= 0) || (x > 0 && y <= 0)
+}
+
+// Derivative returns the central difference of f with step h, in days.
+// The zeros of the derivative are the extrema of f: a falling zero (Rising
+// is false) is a maximum.
+func Derivative(f Func, h float64) Func {
+	return func(jd float64) (float64, error) {
+		fp, err := f(jd + h)
+		if err != nil {
+			return 0, err
+		}
+		fm, err := f(jd - h)
+		if err != nil {
+			return 0, err
+		}
+		return (fp - fm) / (2 * h), nil
+	}
+}
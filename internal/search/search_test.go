@@ -0,0 +1,97 @@
+package search
+
+import (
+	"math"
+	"testing"
+)
+
+func TestRoots(t *testing.T) {
+	f := func(x float64) (float64, error) { return math.Sin(x), nil }
+	roots, err := Roots(f, 0.5, 10, 0.7, 1e-9, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	exp := []Root{{math.Pi, false}, {2 * math.Pi, true}, {3 * math.Pi, false}}
+	if len(roots) != len(exp) {
+		t.Fatalf("Expected %d roots. Got: %v", len(exp), roots)
+	}
+	for i, r := range roots {
+		if math.Abs(r.JD-exp[i].JD) > 1e-9 || r.Rising != exp[i].Rising {
+			t.Errorf("Root #%d should be %v. Got: %v", i, exp[i], r)
+		}
+	}
+}
+
+func TestRootsMaxJump(t *testing.T) {
+	// sawtooth wrapping from π to −π at x = 1, zero at x = 0.5
+	f := func(x float64) (float64, error) {
+		return math.Remainder(2*math.Pi*(x-0.5), 2*math.Pi), nil
+	}
+	roots, err := Roots(f, 0.1, 1.4, 0.1, 1e-9, math.Pi)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(roots) != 1 || math.Abs(roots[0].JD-0.5) > 1e-9 {
+		t.Errorf("Expected a single root at 0.5. Got: %v", roots)
+	}
+}
+
+func TestGrid(t *testing.T) {
+	got := Grid(0, 10, 4, 5, 20)
+	exp := []float64{0, 2.5, 5, 7.5, 10}
+	if len(got) != len(exp) {
+		t.Fatalf("Grid should be %v. Got: %v", exp, got)
+	}
+	for i := range got {
+		if math.Abs(got[i]-exp[i]) > 1e-12 {
+			t.Errorf("Grid should be %v. Got: %v", exp, got)
+			break
+		}
+	}
+}
+
+func TestAngleCrossings(t *testing.T) {
+	// an angle that goes forward one turn per day and turns back at day 1.5
+	f := func(x float64) (float64, error) {
+		if x > 1.5 {
+			x = 3 - x
+		}
+		return math.Remainder(2*math.Pi*x, 2*math.Pi), nil
+	}
+	targets := []float64{0, math.Pi / 2}
+	got, err := AngleCrossings(f, targets, Grid(0.1, 2.9, 0.1, 1.5), 1e-9)
+	if err != nil {
+		t.Fatal(err)
+	}
+	exp := []Crossing{
+		{0.25, math.Pi / 2, true},
+		{1, 0, true},
+		{1.25, math.Pi / 2, true},
+		{1.75, math.Pi / 2, false},
+		{2, 0, false},
+		{2.75, math.Pi / 2, false},
+	}
+	if len(got) != len(exp) {
+		t.Fatalf("Expected %d crossings. Got: %v", len(exp), got)
+	}
+	for i, c := range got {
+		if math.Abs(c.JD-exp[i].JD) > 1e-9 || c.Target != exp[i].Target || c.Increasing != exp[i].Increasing {
+			t.Errorf("Crossing #%d should be %v. Got: %v", i, exp[i], c)
+		}
+	}
+}
+
+func TestDerivativeExtrema(t *testing.T) {
+	f := func(x float64) (float64, error) { return math.Cos(x), nil }
+	roots, err := Roots(Derivative(f, 1e-3), 1, 7, 0.5, 1e-9, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// minimum at π, maximum at 2π
+	if len(roots) != 2 || !roots[0].Rising || roots[1].Rising {
+		t.Fatalf("Expected a minimum and a maximum. Got: %v", roots)
+	}
+	if math.Abs(roots[0].JD-math.Pi) > 1e-8 || math.Abs(roots[1].JD-2*math.Pi) > 1e-8 {
+		t.Errorf("Extrema should be at π and 2π. Got: %v", roots)
+	}
+}
@@ -36,12 +36,43 @@ func geometric(jd float64) (l, b, r float64) {
 // Applies aberration and optional nutation.
 func Apparent(jd, deltaPsi float64) heliocentric.EclCoord {
 	l, b, r := geometric(jd)
-	//l = mathutils.ReduceRad(l + heliocentric.AberrationEcl(r, b) + deltaPsi)
-	return heliocentric.EclCoord{
-		Lambda: mathutils.ReduceRad(l + heliocentric.AberrationEcl(r, b) + deltaPsi),
-		Beta:   b,
-		Radius: r,
+	return reduce(jd, heliocentric.Apparent, heliocentric.FrameOfDate, l, b, r, deltaPsi)
+}
+
+// PositionFrom returns geocentric ecliptic coordinates of the Sun at
+// Julian Day jd, reduced as red and referred to frame. earthLBR is Earth's
+// heliocentric position at jd, as returned by heliocentric.EarthLBR;
+// deltaPsi is used only by apparent positions of date.
+func PositionFrom(jd float64, red heliocentric.Reduction, frame heliocentric.Frame, deltaPsi float64, earthLBR mathutils.Spherical) heliocentric.EclCoord {
+	l := mathutils.ReduceRad(earthLBR.Phi + math.Pi)
+	return reduce(jd, red, frame, l, -earthLBR.Theta, earthLBR.R, deltaPsi)
+}
+
+// StateFrom is like PositionFrom but also returns the rates of change of
+// the coordinates (radians/day, AU/day). earthRate holds the rates of
+// earthLBR, as returned by heliocentric.LBRWithRate; deltaPsi is as in
+// heliocentric.ReduceState.
+func StateFrom(jd float64, red heliocentric.Reduction, frame heliocentric.Frame, deltaPsi func(dt float64) float64, earthLBR, earthRate mathutils.Spherical) (pos, rate heliocentric.EclCoord) {
+	pos = heliocentric.EclCoord{
+		Lambda: mathutils.ReduceRad(earthLBR.Phi + math.Pi),
+		Beta:   -earthLBR.Theta,
+		Radius: earthLBR.R,
 	}
+	rate = heliocentric.EclCoord{Lambda: earthRate.Phi, Beta: -earthRate.Theta, Radius: earthRate.R}
+	return heliocentric.ReduceState(red, frame, jd, pos, rate, deltaPsi, aberration)
+}
+
+// reduce applies reduction red to the geometric coordinates. The Sun is the
+// origin of the heliocentric frame, so light-time does not move it; the
+// whole effect of Earth's motion is the aberration −κ/R.
+func reduce(jd float64, red heliocentric.Reduction, frame heliocentric.Frame, l, b, r, deltaPsi float64) heliocentric.EclCoord {
+	pos := heliocentric.EclCoord{Lambda: l, Beta: b, Radius: r}
+	return heliocentric.Reduce(red, frame, jd, pos, deltaPsi, aberration)
+}
+
+// aberration returns the aberration of the Sun in longitude and latitude.
+func aberration(_ float64, pos heliocentric.EclCoord) (float64, float64) {
+	return heliocentric.AberrationEcl(pos.Radius, pos.Beta), 0
 }
 
 // Rect2000 calculate equatorial rectangular coordinates of the Sun referred
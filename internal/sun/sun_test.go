@@ -3,6 +3,7 @@ package sun
 import (
 	"testing"
 
+	"github.com/ilbagatto/vsop87-go/internal/heliocentric"
 	"github.com/ilbagatto/vsop87-go/mathutils"
 )
 
@@ -62,3 +63,50 @@ func TestRect2000(t *testing.T) {
 		t.Errorf("Z should be %.4f. Got: %.4f", exp.Z, got.Z)
 	}
 }
+
+func TestPositionFrom(t *testing.T) {
+	const jd = 2438792.990277
+	dpsi := -0.00007401181737462798
+	earthLBR := heliocentric.EarthLBR(jd)
+	l, b, r := geometric(jd)
+
+	tests := []struct {
+		name string
+		exp  heliocentric.EclCoord
+		red  heliocentric.Reduction
+	}{
+		{"Apparent", Apparent(jd, dpsi), heliocentric.Apparent},
+		{"Geometric", heliocentric.EclCoord{Lambda: l, Beta: b, Radius: r}, heliocentric.Geometric},
+	}
+	for _, tc := range tests {
+		got := PositionFrom(jd, tc.red, heliocentric.FrameOfDate, dpsi, earthLBR)
+		if !mathutils.AlmostEqual(got.Lambda, tc.exp.Lambda, 1e-12) {
+			t.Errorf("%s: Lambda should be %.12f. Got: %.12f", tc.name, tc.exp.Lambda, got.Lambda)
+		}
+		if !mathutils.AlmostEqual(got.Beta, tc.exp.Beta, 1e-12) {
+			t.Errorf("%s: Beta should be %.12f. Got: %.12f", tc.name, tc.exp.Beta, got.Beta)
+		}
+		if !mathutils.AlmostEqual(got.Radius, tc.exp.Radius, 1e-12) {
+			t.Errorf("%s: Radius should be %.12f. Got: %.12f", tc.name, tc.exp.Radius, got.Radius)
+		}
+	}
+}
+
+func TestStateFrom(t *testing.T) {
+	const (
+		jd = 2448908.5
+		h  = 1.0 / 96
+	)
+	earthLBR, earthRate := heliocentric.LBRWithRate(jd, heliocentric.Earth{})
+	pos, rate := StateFrom(jd, heliocentric.Apparent, heliocentric.FrameOfDate, nil, earthLBR, earthRate)
+	if exp := Apparent(jd, 0); !mathutils.AlmostEqual(pos.Lambda, exp.Lambda, 1e-12) {
+		t.Errorf("Lambda should be %.12f. Got: %.12f", exp.Lambda, pos.Lambda)
+	}
+	p, m := Apparent(jd+h, 0), Apparent(jd-h, 0)
+	if exp := (p.Lambda - m.Lambda) / (2 * h); !mathutils.AlmostEqual(rate.Lambda, exp, 1e-9) {
+		t.Errorf("Lambda rate should be %.12f. Got: %.12f", exp, rate.Lambda)
+	}
+	if exp := (p.Radius - m.Radius) / (2 * h); !mathutils.AlmostEqual(rate.Radius, exp, 1e-9) {
+		t.Errorf("Radius rate should be %.12f. Got: %.12f", exp, rate.Radius)
+	}
+}
@@ -46,3 +46,39 @@ func ComputeSeries(tau float64, series [][]Coeff) float64 {
 	// evaluate the polynomial in tau with variadic args, then scale down by 1e8
 	return mathutils.Polynome(tau, args...)
 }
+
+// ComputeSeriesWithDerivative is like ComputeSeries but also returns the
+// derivative of the series with respect to tau, evaluated in the same pass:
+// each term contributes −A·C·sin(B + C*tau), and the polynome is
+// differentiated by the product rule.
+func ComputeSeriesWithDerivative(tau float64, series [][]Coeff) (value, rate float64) {
+	power := 1.0     // tau^i
+	prevPower := 0.0 // i * tau^(i-1)
+	for i, serie := range series {
+		var sum, dsum float64
+		for _, cf := range serie {
+			sin, cos := math.Sincos(cf.B + cf.C*tau)
+			sum += cf.A * cos
+			dsum -= cf.A * cf.C * sin
+		}
+		value += sum * power
+		rate += dsum*power + sum*prevPower
+		prevPower = float64(i+1) * power
+		power *= tau
+	}
+	return value, rate
+}
+
+// Truncate returns a copy of series without the terms whose amplitude A is
+// below minAmp. The order of the remaining terms is preserved.
+func Truncate(series [][]Coeff, minAmp float64) [][]Coeff {
+	res := make([][]Coeff, len(series))
+	for i, serie := range series {
+		for _, cf := range serie {
+			if math.Abs(cf.A) >= minAmp {
+				res[i] = append(res[i], cf)
+			}
+		}
+	}
+	return res
+}
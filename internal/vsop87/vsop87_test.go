@@ -40,3 +40,30 @@ func TestVenusR(t *testing.T) {
 		t.Errorf("L should be %.4f. Got: %.4f", exp, got)
 	}
 }
+
+func TestTruncate(t *testing.T) {
+	full := vsop87.ComputeSeries(tau, generated.Venus_L)
+	series := vsop87.Truncate(generated.Venus_L, 1e-7)
+	if len(series) != len(generated.Venus_L) {
+		t.Fatalf("Truncate should keep %d sub-series. Got: %d", len(generated.Venus_L), len(series))
+	}
+	if len(series[0]) >= len(generated.Venus_L[0]) {
+		t.Errorf("Truncate should drop small terms")
+	}
+	got := vsop87.ComputeSeries(tau, series)
+	if !mathutils.AlmostEqual(got, full, 1e-5) {
+		t.Errorf("L should be %.6f. Got: %.6f", full, got)
+	}
+}
+
+func TestComputeSeriesWithDerivative(t *testing.T) {
+	const h = 1e-8 // millennia, about 5 minutes
+	value, rate := vsop87.ComputeSeriesWithDerivative(tau, generated.Venus_L)
+	if exp := vsop87.ComputeSeries(tau, generated.Venus_L); !mathutils.AlmostEqual(value, exp, 1e-12) {
+		t.Errorf("L should be %.12f. Got: %.12f", exp, value)
+	}
+	exp := (vsop87.ComputeSeries(tau+h, generated.Venus_L) - vsop87.ComputeSeries(tau-h, generated.Venus_L)) / (2 * h)
+	if !mathutils.AlmostEqual(rate, exp, 1e-5) {
+		t.Errorf("dL/dτ should be %.6f. Got: %.6f", exp, rate)
+	}
+}
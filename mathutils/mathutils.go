@@ -135,3 +135,49 @@ func Hms(x float64) (hours int, minutes int, seconds float64) {
 	}
 	return hours, minutes, seconds
 }
+
+// PolyFit returns the coefficients a1, a2, ... of the polynomial of the
+// given degree that fits the points (xs[i], ys[i]) best in the least-squares
+// sense, in the order expected by Polynome.
+func PolyFit(xs, ys []float64, degree int) []float64 {
+	n := degree + 1
+	// normal equations, augmented with the right-hand side
+	m := make([][]float64, n)
+	for i := range m {
+		m[i] = make([]float64, n+1)
+	}
+	for k, x := range xs {
+		for i := 0; i < n; i++ {
+			xi := math.Pow(x, float64(i))
+			for j := 0; j < n; j++ {
+				m[i][j] += xi * math.Pow(x, float64(j))
+			}
+			m[i][n] += xi * ys[k]
+		}
+	}
+	// Gaussian elimination with partial pivoting
+	for c := 0; c < n; c++ {
+		p := c
+		for r := c + 1; r < n; r++ {
+			if math.Abs(m[r][c]) > math.Abs(m[p][c]) {
+				p = r
+			}
+		}
+		m[c], m[p] = m[p], m[c]
+		for r := c + 1; r < n; r++ {
+			f := m[r][c] / m[c][c]
+			for j := c; j <= n; j++ {
+				m[r][j] -= f * m[c][j]
+			}
+		}
+	}
+	coeffs := make([]float64, n)
+	for i := n - 1; i >= 0; i-- {
+		s := m[i][n]
+		for j := i + 1; j < n; j++ {
+			s -= m[i][j] * coeffs[j]
+		}
+		coeffs[i] = s / m[i][i]
+	}
+	return coeffs
+}
@@ -37,6 +37,21 @@ func TestLongPolynome(t *testing.T) {
 	}
 }
 
+func TestPolyFit(t *testing.T) {
+	xs := []float64{-3, -2, -1, 0, 1, 2, 3}
+	ys := make([]float64, len(xs))
+	for i, x := range xs {
+		ys[i] = mathutils.Polynome(x, 0.5, -1.25, 0.01, 0.002)
+	}
+	got := mathutils.PolyFit(xs, ys, 3)
+	exp := []float64{0.5, -1.25, 0.01, 0.002}
+	for i := range exp {
+		if !mathutils.AlmostEqual(got[i], exp[i], 1e-12) {
+			t.Errorf("Coefficient %d: expected: %.12f, got: %.12f", i, exp[i], got[i])
+		}
+	}
+}
+
 func TestReduceHoursPositive(t *testing.T) {
 	if !mathutils.AlmostEqual(mathutils.ReduceHours(49.5), 1.5, 1e-6) {
 		t.Errorf("49.5 should be reduced to 1.5")